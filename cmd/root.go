@@ -4,15 +4,18 @@ import (
 	"fmt"
 	"os"
 
+	"sbcntr2-test-tool/internal/i18n"
+
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
 
 var cfgFile string
-var verbose bool
+var verbose int
 var outputFormat string
 var region string
 var profile string
+var lang string
 
 var rootCmd = &cobra.Command{
 	Use:   "sbcntr-validator",
@@ -22,6 +25,9 @@ created during the SBCNTR hands-on exercises using AWS Cloud Control API.
 
 It checks if resources are correctly created and configured at each step,
 helping learners ensure they are progressing correctly.`,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		return setLang(lang)
+	},
 }
 
 func Execute() {
@@ -35,10 +41,11 @@ func init() {
 	cobra.OnInitialize(initConfig)
 
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.sbcntr-validator.yaml)")
-	rootCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "verbose output")
-	rootCmd.PersistentFlags().StringVarP(&outputFormat, "output", "o", "console", "output format (console, json)")
+	rootCmd.PersistentFlags().CountVarP(&verbose, "verbose", "v", "verbose output (-vv to include raw AWS API responses)")
+	rootCmd.PersistentFlags().StringVarP(&outputFormat, "output", "o", "console", "output format (console, json, ndjson, junit, sarif, markdown, html, template)")
 	rootCmd.PersistentFlags().StringVarP(&region, "region", "r", "ap-northeast-1", "AWS region")
 	rootCmd.PersistentFlags().StringVarP(&profile, "profile", "p", "", "AWS profile")
+	rootCmd.PersistentFlags().StringVar(&lang, "lang", "", "message language (ja, en; also SBCNTR_LANG). Defaults to the language of LC_ALL/LC_MESSAGES/LANG")
 
 	viper.BindPFlag("verbose", rootCmd.PersistentFlags().Lookup("verbose"))
 	viper.BindPFlag("output", rootCmd.PersistentFlags().Lookup("output"))
@@ -61,8 +68,27 @@ func initConfig() {
 	viper.AutomaticEnv()
 
 	if err := viper.ReadInConfig(); err == nil {
-		if verbose {
+		if verbose > 0 {
 			fmt.Fprintln(os.Stderr, "Using config file:", viper.ConfigFileUsed())
 		}
 	}
 }
+
+// setLang はメッセージの言語を設定する。--lang と SBCNTR_LANG の指定がない場合はロケールの環境変数から判定する
+// viperのキーにするとAutomaticEnvがロケールのLANG（en_US.UTF-8等）を読んでしまうため、フラグの値を直接使う
+func setLang(value string) error {
+	if value == "" {
+		value = os.Getenv("SBCNTR_LANG")
+	}
+	if value == "" {
+		i18n.SetLang(i18n.DetectLang())
+		return nil
+	}
+
+	l, err := i18n.ParseLang(value)
+	if err != nil {
+		return err
+	}
+	i18n.SetLang(l)
+	return nil
+}
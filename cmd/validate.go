@@ -1,11 +1,18 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
+	"io"
+	"os"
+	"path/filepath"
 	"sbcntr2-test-tool/internal/aws"
 	"sbcntr2-test-tool/internal/config"
 	"sbcntr2-test-tool/internal/reporter"
 	"sbcntr2-test-tool/internal/validator"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -13,6 +20,14 @@ import (
 
 var step int
 var allSteps bool
+var detectDrift bool
+var driftTimeout time.Duration
+var templateFile string
+var outputFile string
+var reports []string
+var noColor bool
+var asciiOutput bool
+var compact bool
 
 var validateCmd = &cobra.Command{
 	Use:   "validate",
@@ -26,13 +41,30 @@ func init() {
 
 	validateCmd.Flags().IntVarP(&step, "step", "s", 0, "Step number to validate (1-7)")
 	validateCmd.Flags().BoolVarP(&allSteps, "all", "a", false, "Validate all steps")
+	validateCmd.Flags().BoolVar(&detectDrift, "detect-drift", false, "Detect drift of CloudFormation stacks")
+	validateCmd.Flags().DurationVar(&driftTimeout, "drift-timeout", 5*time.Minute, "Timeout for each CloudFormation drift detection")
+	validateCmd.Flags().StringVar(&templateFile, "template-file", "", "Go text/template file used with --output template")
+	validateCmd.Flags().StringVar(&outputFile, "output-file", "", "Write the --output report to a file instead of stdout")
+	validateCmd.Flags().BoolVar(&noColor, "no-color", false, "Disable colored console and template output (also disabled by the NO_COLOR environment variable)")
+	validateCmd.Flags().BoolVar(&asciiOutput, "ascii", false, "Use ASCII characters instead of emoji and box-drawing characters in console output")
+	validateCmd.Flags().BoolVar(&compact, "compact", false, "Print one line per resource in console output")
+	validateCmd.Flags().StringArrayVar(&reports, "report", nil, "Additional report as format=path (repeatable, e.g. --report json=result.json --report junit=junit.xml)")
 }
 
-func runValidate(cmd *cobra.Command, args []string) error {
+func runValidate(cmd *cobra.Command, args []string) (err error) {
 	if !allSteps && (step < 1 || step > 7) {
 		return fmt.Errorf("please specify a valid step number (1-7) or use --all flag")
 	}
 
+	rep, closeReports, err := buildReporters(viper.GetString("output"), outputFile, reports)
+	if err != nil {
+		return err
+	}
+	// 書き込みの失敗はCloseで分かることがあるため、Closeのエラーも返す
+	defer func() {
+		err = errors.Join(err, closeReports())
+	}()
+
 	awsClient, err := aws.NewClient(
 		viper.GetString("region"),
 		viper.GetString("profile"),
@@ -43,12 +75,14 @@ func runValidate(cmd *cobra.Command, args []string) error {
 
 	configManager := config.NewManager()
 	validationEngine := validator.NewEngine(awsClient, configManager)
-
-	var rep reporter.Reporter
-	if viper.GetString("output") == "json" {
-		rep = reporter.NewJSONReporter()
-	} else {
-		rep = reporter.NewConsoleReporter(viper.GetBool("verbose"))
+	if detectDrift {
+		validationEngine.EnableDriftDetection(driftTimeout)
+	}
+	if viper.GetInt("verbose") >= 2 {
+		validationEngine.EnableAPICallRecording()
+	}
+	for _, observer := range reporter.Observers(rep) {
+		validationEngine.AddObserver(observer)
 	}
 
 	if allSteps {
@@ -65,3 +99,127 @@ func runValidate(cmd *cobra.Command, args []string) error {
 		return rep.ReportResult(result)
 	}
 }
+
+var outputFormats = []string{"console", "json", "ndjson", "junit", "sarif", "markdown", "html", "template"}
+
+// buildReporters は --output と --report で指定されたレポーターをまとめる
+// AWSへの問い合わせは1回にして、同じ検証結果をすべての出力先に書き出す
+func buildReporters(format, path string, extra []string) (reporter.Reporter, func() error, error) {
+	var files []*os.File
+	closeFiles := func() error {
+		var errs []error
+		for _, f := range files {
+			if err := f.Close(); err != nil {
+				errs = append(errs, fmt.Errorf("failed to close report file: %w", err))
+			}
+		}
+		return errors.Join(errs...)
+	}
+
+	open := func(path string) (io.Writer, error) {
+		if path == "" || path == "-" {
+			return os.Stdout, nil
+		}
+		f, err := os.Create(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create report file: %w", err)
+		}
+		files = append(files, f)
+		return f, nil
+	}
+
+	targets := [][2]string{{format, path}}
+	for _, report := range extra {
+		reportFormat, reportPath, ok := strings.Cut(report, "=")
+		if !ok || reportFormat == "" || reportPath == "" {
+			return nil, nil, fmt.Errorf("invalid --report %q: expected format=path", report)
+		}
+		targets = append(targets, [2]string{reportFormat, reportPath})
+	}
+
+	// 不正な形式でファイルを作らないよう、出力先を開く前に形式とパスを確認する
+	// 同じファイルに複数のレポートを書くと互いに上書きし、標準出力では混ざるため、出力先の重複は認めない
+	paths := make(map[string]string)
+	stdout := ""
+	for i, target := range targets {
+		if target[0] != "" && !slices.Contains(outputFormats, target[0]) {
+			return nil, nil, fmt.Errorf("unknown output format: %s (available: %s)", target[0], strings.Join(outputFormats, ", "))
+		}
+		if target[1] == "" || target[1] == "-" {
+			name := "--output"
+			if i > 0 {
+				name = "--report " + extra[i-1]
+			}
+			if stdout != "" {
+				return nil, nil, fmt.Errorf("%s and %s both write to standard output; use --output-file or a file path for one of them", stdout, name)
+			}
+			stdout = name
+			continue
+		}
+		abs, err := filepath.Abs(target[1])
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid report path %s: %w", target[1], err)
+		}
+		if previous, ok := paths[abs]; ok {
+			return nil, nil, fmt.Errorf("%s and %s refer to the same file; use a different path for each report", previous, target[1])
+		}
+		paths[abs] = target[1]
+	}
+
+	var reps []reporter.Reporter
+	for _, target := range targets {
+		out, err := open(target[1])
+		if err != nil {
+			closeFiles()
+			return nil, nil, err
+		}
+		rep, err := newReporter(target[0], out)
+		if err != nil {
+			closeFiles()
+			return nil, nil, err
+		}
+		reps = append(reps, rep)
+	}
+
+	if len(reps) == 1 {
+		return reps[0], closeFiles, nil
+	}
+	return reporter.NewMultiReporter(reps...), closeFiles, nil
+}
+
+func newReporter(format string, out io.Writer) (reporter.Reporter, error) {
+	switch format {
+	case "json":
+		return reporter.NewJSONReporter(out), nil
+	case "ndjson":
+		return reporter.NewNDJSONReporter(out), nil
+	case "junit":
+		return reporter.NewJUnitReporter(out), nil
+	case "sarif":
+		return reporter.NewSARIFReporter(out), nil
+	case "markdown":
+		return reporter.NewMarkdownReporter(out), nil
+	case "html":
+		return reporter.NewHTMLReporter(out), nil
+	case "template":
+		return reporter.NewTemplateReporter(out, templateFile, consoleOptions(out).Color)
+	case "console", "":
+		return reporter.NewConsoleReporter(out, consoleOptions(out)), nil
+	default:
+		return nil, fmt.Errorf("unknown output format: %s", format)
+	}
+}
+
+// consoleOptions は出力先の端末とコマンドラインの指定から表示方法を決める
+func consoleOptions(out io.Writer) reporter.ConsoleOptions {
+	options := reporter.DetectConsoleOptions(out)
+	options.Verbosity = viper.GetInt("verbose")
+	options.Compact = compact
+	if noColor {
+		options.Color = false
+	}
+	if asciiOutput {
+		options.ASCII = true
+	}
+	return options
+}
@@ -3,27 +3,40 @@ package aws
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/ecr"
 	"github.com/aws/aws-sdk-go-v2/service/ecs"
 	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
 )
 
 type Client struct {
 	cfg            aws.Config
 	CloudControl   *cloudcontrol.Client
 	CloudFormation *cloudformation.Client
+	CloudWatchLogs *cloudwatchlogs.Client
 	EC2            *ec2.Client
 	ECR            *ecr.Client
 	ECS            *ecs.Client
 	ELBv2          *elasticloadbalancingv2.Client
 	IAM            *iam.Client
+	RDS            *rds.Client
+	SecretsManager *secretsmanager.Client
+	SSM            *ssm.Client
+
+	// Cloud Controlのリソースタイプスキーマのキャッシュ
+	schemaMu sync.Mutex
+	schemas  map[string]*ResourceSchema
 }
 
 func NewClient(region string, profile string) (*Client, error) {
@@ -43,16 +56,22 @@ func NewClient(region string, profile string) (*Client, error) {
 	if err != nil {
 		return nil, fmt.Errorf("unable to load SDK config: %w", err)
 	}
+	cfg.APIOptions = append(cfg.APIOptions, recordAPICalls)
 
 	return &Client{
 		cfg:            cfg,
 		CloudControl:   cloudcontrol.NewFromConfig(cfg),
 		CloudFormation: cloudformation.NewFromConfig(cfg),
+		CloudWatchLogs: cloudwatchlogs.NewFromConfig(cfg),
 		EC2:            ec2.NewFromConfig(cfg),
 		ECR:            ecr.NewFromConfig(cfg),
 		ECS:            ecs.NewFromConfig(cfg),
 		ELBv2:          elasticloadbalancingv2.NewFromConfig(cfg),
 		IAM:            iam.NewFromConfig(cfg),
+		RDS:            rds.NewFromConfig(cfg),
+		SecretsManager: secretsmanager.NewFromConfig(cfg),
+		SSM:            ssm.NewFromConfig(cfg),
+		schemas:        make(map[string]*ResourceSchema),
 	}, nil
 }
 
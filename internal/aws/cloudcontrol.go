@@ -4,8 +4,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
 )
 
 type CloudControlResource struct {
@@ -82,3 +87,93 @@ func (c *Client) ListResources(ctx context.Context, resourceType string) ([]*Clo
 
 	return resources, nil
 }
+
+// ResourceSchema はCloudFormationのリソースタイプスキーマのうち、リソースの検索に使う情報
+type ResourceSchema struct {
+	TypeName          string
+	PrimaryIdentifier []string
+	Properties        []string
+	TagProperty       string
+}
+
+// resourceTypeSchema はDescribeTypeが返すスキーマJSONの必要な部分
+type resourceTypeSchema struct {
+	TypeName          string                 `json:"typeName"`
+	Properties        map[string]interface{} `json:"properties"`
+	PrimaryIdentifier []string               `json:"primaryIdentifier"`
+	Tagging           struct {
+		Taggable    *bool  `json:"taggable"`
+		TagProperty string `json:"tagProperty"`
+	} `json:"tagging"`
+}
+
+// GetResourceSchema はリソースタイプのスキーマを取得する。取得したスキーマはキャッシュする
+func (c *Client) GetResourceSchema(ctx context.Context, resourceType string) (*ResourceSchema, error) {
+	c.schemaMu.Lock()
+	defer c.schemaMu.Unlock()
+
+	if schema, ok := c.schemas[resourceType]; ok {
+		return schema, nil
+	}
+
+	result, err := c.CloudFormation.DescribeType(ctx, &cloudformation.DescribeTypeInput{
+		Type:     types.RegistryTypeResource,
+		TypeName: aws.String(resourceType),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe resource type %s: %w", resourceType, err)
+	}
+	if result.Schema == nil {
+		return nil, fmt.Errorf("schema of resource type %s is empty", resourceType)
+	}
+
+	var raw resourceTypeSchema
+	if err := json.Unmarshal([]byte(*result.Schema), &raw); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal schema of resource type %s: %w", resourceType, err)
+	}
+
+	schema := &ResourceSchema{
+		TypeName:   resourceType,
+		Properties: make([]string, 0, len(raw.Properties)),
+	}
+	for name := range raw.Properties {
+		schema.Properties = append(schema.Properties, name)
+	}
+	sort.Strings(schema.Properties)
+
+	// "/properties/Arn" のようなJSONポインタからプロパティ名を取り出す
+	for _, pointer := range raw.PrimaryIdentifier {
+		schema.PrimaryIdentifier = append(schema.PrimaryIdentifier, strings.TrimPrefix(pointer, "/properties/"))
+	}
+
+	if raw.Tagging.TagProperty != "" {
+		schema.TagProperty = strings.TrimPrefix(raw.Tagging.TagProperty, "/properties/")
+	} else if _, ok := raw.Properties["Tags"]; ok && (raw.Tagging.Taggable == nil || *raw.Tagging.Taggable) {
+		schema.TagProperty = "Tags"
+	}
+
+	c.schemas[resourceType] = schema
+	return schema, nil
+}
+
+// NameProperties はリソース名を表す可能性のあるプロパティを優先度順に返す
+// 例: AWS::SNS::Topic -> ["Name", "TopicName", "DisplayName"]
+func (s *ResourceSchema) NameProperties() []string {
+	parts := strings.Split(s.TypeName, "::")
+	typeNameProperty := parts[len(parts)-1] + "Name"
+
+	var names []string
+	var others []string
+	for _, property := range s.Properties {
+		switch {
+		case property == "Name":
+			names = append([]string{property}, names...)
+		case property == typeNameProperty:
+			names = append(names, property)
+		case strings.HasSuffix(property, "Name"):
+			others = append(others, property)
+		}
+	}
+
+	return append(names, others...)
+}
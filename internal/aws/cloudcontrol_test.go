@@ -0,0 +1,26 @@
+package aws
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestResourceSchemaNameProperties(t *testing.T) {
+	tests := []struct {
+		typeName   string
+		properties []string
+		want       []string
+	}{
+		{"AWS::SNS::Topic", []string{"DisplayName", "Name", "TopicName"}, []string{"Name", "TopicName", "DisplayName"}},
+		{"AWS::SNS::Topic", []string{"TopicName", "Name"}, []string{"Name", "TopicName"}},
+		{"AWS::Logs::LogGroup", []string{"Arn", "LogGroupName", "RetentionInDays"}, []string{"LogGroupName"}},
+		{"AWS::SQS::Queue", []string{"Arn", "QueueUrl"}, nil},
+	}
+
+	for _, tt := range tests {
+		schema := &ResourceSchema{TypeName: tt.typeName, Properties: tt.properties}
+		if got := schema.NameProperties(); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("NameProperties(%s, %v) = %v, want %v", tt.typeName, tt.properties, got, tt.want)
+		}
+	}
+}
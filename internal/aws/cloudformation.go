@@ -2,7 +2,9 @@ package aws
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
@@ -23,6 +25,32 @@ type CloudFormationResource struct {
 	Status     string
 }
 
+type CloudFormationStackDrift struct {
+	StackName        string
+	DetectionStatus  string
+	DriftStatus      string
+	DriftedResources int
+	Resources        []CloudFormationResourceDrift
+}
+
+type CloudFormationResourceDrift struct {
+	LogicalID           string
+	PhysicalID          string
+	Type                string
+	DriftStatus         string
+	PropertyDifferences []CloudFormationPropertyDifference
+}
+
+type CloudFormationPropertyDifference struct {
+	PropertyPath   string
+	ExpectedValue  string
+	ActualValue    string
+	DifferenceType string
+}
+
+// driftPollInterval はドリフト検出の完了を確認する間隔
+const driftPollInterval = 5 * time.Second
+
 func (c *Client) GetCloudFormationStack(ctx context.Context, stackName string) (*CloudFormationStack, error) {
 	describeInput := &cloudformation.DescribeStacksInput{
 		StackName: &stackName,
@@ -67,6 +95,128 @@ func (c *Client) GetCloudFormationStack(ctx context.Context, stackName string) (
 	return cfStack, nil
 }
 
+// ErrDriftDetectionTimeout はドリフト検出がタイムアウトまでに完了しなかったことを表す
+var ErrDriftDetectionTimeout = errors.New("drift detection timed out")
+
+// DetectCloudFormationStackDrift はスタックのドリフト検出を開始し、完了まで待機して結果を返す
+// timeoutは検出の完了を待つ間だけに適用し、完了後のリソースのドリフトの取得には適用しない
+func (c *Client) DetectCloudFormationStackDrift(ctx context.Context, stackName string, timeout time.Duration) (*CloudFormationStackDrift, error) {
+	detectResult, err := c.CloudFormation.DetectStackDrift(ctx, &cloudformation.DetectStackDriftInput{
+		StackName: &stackName,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to start drift detection for stack %s: %w", stackName, err)
+	}
+
+	pollCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(driftPollInterval)
+	defer ticker.Stop()
+
+	// 待機中のAPI呼び出しがタイムアウトで中断された場合も、タイムアウトとして返す
+	timedOut := func() error {
+		return fmt.Errorf("%w: stack %s did not finish within %v", ErrDriftDetectionTimeout, stackName, timeout)
+	}
+
+	var status *cloudformation.DescribeStackDriftDetectionStatusOutput
+	for {
+		status, err = c.CloudFormation.DescribeStackDriftDetectionStatus(pollCtx, &cloudformation.DescribeStackDriftDetectionStatusInput{
+			StackDriftDetectionId: detectResult.StackDriftDetectionId,
+		})
+		if err != nil {
+			if pollCtx.Err() == context.DeadlineExceeded {
+				return nil, timedOut()
+			}
+			return nil, fmt.Errorf("failed to get drift detection status for stack %s: %w", stackName, err)
+		}
+
+		if status.DetectionStatus != types.StackDriftDetectionStatusDetectionInProgress {
+			break
+		}
+
+		select {
+		case <-pollCtx.Done():
+			if pollCtx.Err() == context.DeadlineExceeded {
+				return nil, timedOut()
+			}
+			return nil, pollCtx.Err()
+		case <-ticker.C:
+		}
+	}
+
+	drift := &CloudFormationStackDrift{
+		StackName:       stackName,
+		DetectionStatus: string(status.DetectionStatus),
+		DriftStatus:     string(status.StackDriftStatus),
+	}
+	if status.DriftedStackResourceCount != nil {
+		drift.DriftedResources = int(*status.DriftedStackResourceCount)
+	}
+
+	// DETECTION_FAILEDでも一部のリソースは検出済みの場合があるため、リソースのドリフトは取得する
+	resources, err := c.ListCloudFormationResourceDrifts(ctx, stackName)
+	if err != nil {
+		return nil, err
+	}
+	drift.Resources = resources
+
+	return drift, nil
+}
+
+// ListCloudFormationResourceDrifts は直近のドリフト検出で記録されたスタックのリソースごとのドリフトとプロパティの差分を返す
+func (c *Client) ListCloudFormationResourceDrifts(ctx context.Context, stackName string) ([]CloudFormationResourceDrift, error) {
+	input := &cloudformation.DescribeStackResourceDriftsInput{
+		StackName: &stackName,
+	}
+
+	var drifts []CloudFormationResourceDrift
+	paginator := cloudformation.NewDescribeStackResourceDriftsPaginator(c.CloudFormation, input)
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe stack resource drifts: %w", err)
+		}
+
+		for _, res := range page.StackResourceDrifts {
+			drift := CloudFormationResourceDrift{
+				DriftStatus: string(res.StackResourceDriftStatus),
+			}
+
+			if res.LogicalResourceId != nil {
+				drift.LogicalID = *res.LogicalResourceId
+			}
+			if res.PhysicalResourceId != nil {
+				drift.PhysicalID = *res.PhysicalResourceId
+			}
+			if res.ResourceType != nil {
+				drift.Type = *res.ResourceType
+			}
+
+			for _, diff := range res.PropertyDifferences {
+				difference := CloudFormationPropertyDifference{
+					DifferenceType: string(diff.DifferenceType),
+				}
+				if diff.PropertyPath != nil {
+					difference.PropertyPath = *diff.PropertyPath
+				}
+				if diff.ExpectedValue != nil {
+					difference.ExpectedValue = *diff.ExpectedValue
+				}
+				if diff.ActualValue != nil {
+					difference.ActualValue = *diff.ActualValue
+				}
+				drift.PropertyDifferences = append(drift.PropertyDifferences, difference)
+			}
+
+			drifts = append(drifts, drift)
+		}
+	}
+
+	return drifts, nil
+}
+
 func (c *Client) ListCloudFormationResources(ctx context.Context, stackName string) ([]CloudFormationResource, error) {
 	input := &cloudformation.ListStackResourcesInput{
 		StackName: &stackName,
@@ -0,0 +1,73 @@
+package aws
+
+import (
+	"context"
+	"sync"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// APICall はAWS APIの呼び出しとそのレスポンス
+type APICall struct {
+	Service   string
+	Operation string
+	Response  interface{}
+}
+
+// APICallRecorder はコンテキストに紐づけてAWS APIのレスポンスを記録する
+type APICallRecorder struct {
+	mu    sync.Mutex
+	calls []APICall
+}
+
+type apiCallRecorderKey struct{}
+
+// redactedOperations はレスポンスにシークレットの値を含むため、レスポンスを記録しないAPI
+var redactedOperations = map[string]bool{
+	"GetSecretValue": true,
+}
+
+// WithAPICallRecorder はこのコンテキストで呼び出したAWS APIのレスポンスを記録するレコーダーを設定する
+func WithAPICallRecorder(ctx context.Context) (context.Context, *APICallRecorder) {
+	recorder := &APICallRecorder{calls: []APICall{}}
+	return context.WithValue(ctx, apiCallRecorderKey{}, recorder), recorder
+}
+
+// Calls は記録したAPI呼び出しを呼び出し順に返す
+func (r *APICallRecorder) Calls() []APICall {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]APICall{}, r.calls...)
+}
+
+func (r *APICallRecorder) add(call APICall) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.calls = append(r.calls, call)
+}
+
+// recordAPICalls はレコーダーが設定されたコンテキストでの呼び出し結果を記録するミドルウェアを追加する
+// リトライを含めた1回の呼び出しにつき、最終的なレスポンスを1件記録する
+func recordAPICalls(stack *middleware.Stack) error {
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("RecordAPICall",
+		func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+			out, metadata, err := next.HandleInitialize(ctx, in)
+
+			if recorder, ok := ctx.Value(apiCallRecorderKey{}).(*APICallRecorder); ok && err == nil {
+				call := APICall{
+					Service:   awsmiddleware.GetServiceID(ctx),
+					Operation: awsmiddleware.GetOperationName(ctx),
+					Response:  out.Result,
+				}
+				if redactedOperations[call.Operation] {
+					call.Response = "(redacted)"
+				}
+				recorder.add(call)
+			}
+
+			return out, metadata, err
+		}), middleware.After)
+}
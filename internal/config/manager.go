@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"gopkg.in/yaml.v3"
 )
@@ -40,38 +41,48 @@ func (m *Manager) LoadStepConfig(stepNumber int) (*StepConfig, error) {
 	return &config, nil
 }
 
+// resourceConfigFiles はリソースタイプからルールファイル名へのマッピング
+var resourceConfigFiles = map[string]string{
+	"AWS::EC2::VPC":                             "vpc.yaml",
+	"AWS::EC2::Subnet":                          "subnet.yaml",
+	"AWS::EC2::SecurityGroup":                   "security_group.yaml",
+	"AWS::EC2::InternetGateway":                 "internet_gateway.yaml",
+	"AWS::EC2::VPCEndpoint":                     "vpce.yaml",
+	"AWS::EC2::NatGateway":                      "nat_gateway.yaml",
+	"AWS::EC2::EIP":                             "eip.yaml",
+	"AWS::ECR::Repository":                      "ecr.yaml",
+	"AWS::ECS::Cluster":                         "ecs.yaml",
+	"AWS::ECS::TaskDefinition":                  "ecs_task_definition.yaml",
+	"AWS::ECS::Service":                         "ecs_service.yaml",
+	"AWS::ElasticLoadBalancingV2::LoadBalancer": "alb.yaml",
+	"AWS::ElasticLoadBalancingV2::TargetGroup":  "target_group.yaml",
+	"AWS::ElasticLoadBalancingV2::Listener":     "alb_listener.yaml",
+	"AWS::RDS::DBCluster":                       "aurora.yaml",
+	"AWS::RDS::DBInstance":                      "rds_instance.yaml",
+	"AWS::RDS::DBSubnetGroup":                   "rds_subnet_group.yaml",
+	"AWS::IAM::Role":                            "iam_role.yaml",
+	"AWS::SecretsManager::Secret":               "secret.yaml",
+	"AWS::SSM::Parameter":                       "ssm_parameter.yaml",
+	"AWS::Logs::LogGroup":                       "log_group.yaml",
+}
+
 func (m *Manager) LoadResourceConfig(resourceType string) (*ResourceConfig, error) {
 	if config, exists := m.resources[resourceType]; exists {
 		return config, nil
 	}
 
-	// リソースタイプからファイル名にマッピング
-	fileMap := map[string]string{
-		"AWS::EC2::VPC":                             "vpc.yaml",
-		"AWS::EC2::Subnet":                          "subnet.yaml",
-		"AWS::EC2::SecurityGroup":                   "security_group.yaml",
-		"AWS::EC2::InternetGateway":                 "internet_gateway.yaml",
-		"AWS::EC2::VPCEndpoint":                     "vpce.yaml",
-		"AWS::ECR::Repository":                      "ecr.yaml",
-		"AWS::ECS::Cluster":                         "ecs.yaml",
-		"AWS::ECS::TaskDefinition":                  "ecs_task_definition.yaml",
-		"AWS::ECS::Service":                         "ecs_service.yaml",
-		"AWS::ElasticLoadBalancingV2::LoadBalancer": "alb.yaml",
-		"AWS::ElasticLoadBalancingV2::TargetGroup":  "target_group.yaml",
-		"AWS::RDS::DBCluster":                       "aurora.yaml",
-		"AWS::RDS::DBInstance":                      "rds_instance.yaml",
-		"AWS::RDS::DBSubnetGroup":                   "rds_subnet_group.yaml",
-		"AWS::IAM::Role":                            "iam_role.yaml",
-	}
-
-	yamlFile, ok := fileMap[resourceType]
+	yamlFile, ok := resourceConfigFiles[resourceType]
 	if !ok {
-		// マッピングがない場合はデフォルトで空のルールを返す
-		m.resources[resourceType] = &ResourceConfig{
-			Type:            resourceType,
-			ValidationRules: []ValidationRule{},
+		// マッピングがない場合はタイプ名から導いたファイル（AWS::SNS::Topic -> sns_topic.yaml）を探す
+		yamlFile = defaultResourceConfigFile(resourceType)
+		if _, err := os.Stat(filepath.Join("internal", "config", "configs", "resources", yamlFile)); yamlFile == "" || err != nil {
+			// ファイルもない場合はデフォルトで空のルールを返す
+			m.resources[resourceType] = &ResourceConfig{
+				Type:            resourceType,
+				ValidationRules: []ValidationRule{},
+			}
+			return m.resources[resourceType], nil
 		}
-		return m.resources[resourceType], nil
 	}
 
 	filename := filepath.Join("internal", "config", "configs", "resources", yamlFile)
@@ -89,6 +100,15 @@ func (m *Manager) LoadResourceConfig(resourceType string) (*ResourceConfig, erro
 	return &config, nil
 }
 
+// defaultResourceConfigFile はリソースタイプからルールファイル名を導く
+func defaultResourceConfigFile(resourceType string) string {
+	parts := strings.Split(resourceType, "::")
+	if len(parts) != 3 {
+		return ""
+	}
+	return strings.ToLower(parts[1]+"_"+parts[2]) + ".yaml"
+}
+
 func (m *Manager) GetValidationRules(resourceType string) ([]ValidationRule, error) {
 	config, err := m.LoadResourceConfig(resourceType)
 	if err != nil {
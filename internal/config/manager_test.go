@@ -0,0 +1,145 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// chdirRepoRoot はManagerが読むリポジトリルートからの相対パスを解決できるよう作業ディレクトリを移す
+func chdirRepoRoot(t *testing.T) {
+	t.Helper()
+
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(filepath.Join("..", "..")); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		os.Chdir(wd)
+	})
+}
+
+func TestLoadAllStepConfigs(t *testing.T) {
+	chdirRepoRoot(t)
+
+	files, err := filepath.Glob(filepath.Join("internal", "config", "configs", "steps", "step*.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) == 0 {
+		t.Fatal("no step config files found")
+	}
+
+	m := NewManager()
+	for _, file := range files {
+		name := strings.TrimSuffix(filepath.Base(file), ".yaml")
+		stepNumber, err := strconv.Atoi(strings.TrimPrefix(name, "step"))
+		if err != nil {
+			t.Errorf("%s: unexpected file name", file)
+			continue
+		}
+
+		step, err := m.LoadStepConfig(stepNumber)
+		if err != nil {
+			t.Errorf("%s: %v", file, err)
+			continue
+		}
+		if step.Number != stepNumber {
+			t.Errorf("%s: number is %d, want %d", file, step.Number, stepNumber)
+		}
+		for _, dep := range step.Dependencies {
+			if dep < 1 || dep >= stepNumber {
+				t.Errorf("%s: dependency %d must be an earlier step", file, dep)
+			}
+		}
+
+		// ステップが参照するルールがリソースのルールファイルに定義されていること
+		for _, res := range step.Resources {
+			rules, err := m.GetValidationRules(res.Type)
+			if err != nil {
+				t.Errorf("%s: %s: %v", file, res.Type, err)
+				continue
+			}
+			defined := make(map[string]bool, len(rules))
+			for _, rule := range rules {
+				defined[rule.Name] = true
+			}
+			for _, ruleName := range res.ValidationRules {
+				if !defined[ruleName] {
+					t.Errorf("%s: %s %q: rule %q is not defined", file, res.Type, res.Name, ruleName)
+				}
+			}
+		}
+	}
+}
+
+func TestLoadAllResourceConfigs(t *testing.T) {
+	chdirRepoRoot(t)
+
+	files, err := filepath.Glob(filepath.Join("internal", "config", "configs", "resources", "*.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) == 0 {
+		t.Fatal("no resource config files found")
+	}
+
+	typeByFile := make(map[string]string, len(resourceConfigFiles))
+	for resourceType, file := range resourceConfigFiles {
+		typeByFile[file] = resourceType
+	}
+
+	m := NewManager()
+	for _, file := range files {
+		base := filepath.Base(file)
+		resourceType, ok := typeByFile[base]
+		if !ok {
+			t.Errorf("%s: not mapped to any resource type", file)
+			continue
+		}
+
+		config, err := m.LoadResourceConfig(resourceType)
+		if err != nil {
+			t.Errorf("%s: %v", file, err)
+			continue
+		}
+		if config.Type != resourceType {
+			t.Errorf("%s: type is %q, want %q", file, config.Type, resourceType)
+		}
+
+		names := make(map[string]bool, len(config.ValidationRules))
+		for _, rule := range config.ValidationRules {
+			if rule.Name == "" {
+				t.Errorf("%s: rule without name", file)
+			}
+			if names[rule.Name] {
+				t.Errorf("%s: duplicate rule %q", file, rule.Name)
+			}
+			names[rule.Name] = true
+		}
+	}
+}
+
+func TestDefaultResourceConfigFile(t *testing.T) {
+	tests := []struct {
+		resourceType string
+		want         string
+	}{
+		{"AWS::SNS::Topic", "sns_topic.yaml"},
+		{"AWS::Logs::LogGroup", "logs_loggroup.yaml"},
+		{"AWS::ElasticLoadBalancingV2::TargetGroup", "elasticloadbalancingv2_targetgroup.yaml"},
+		{"AWS::SNS", ""},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		if got := defaultResourceConfigFile(tt.resourceType); got != tt.want {
+			t.Errorf("defaultResourceConfigFile(%q) = %q, want %q", tt.resourceType, got, tt.want)
+		}
+	}
+}
@@ -1,5 +1,13 @@
 package config
 
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+
+	"sbcntr2-test-tool/internal/i18n"
+)
+
 type StepConfig struct {
 	Number               int                  `yaml:"number"`
 	Name                 string               `yaml:"name"`
@@ -7,12 +15,23 @@ type StepConfig struct {
 	Resources            []ResourceDefinition `yaml:"resources"`
 	CloudFormationStacks []string             `yaml:"cloudformation_stacks"`
 	Dependencies         []int                `yaml:"dependencies"`
+	Flows                []FlowDefinition     `yaml:"flows"`
+}
+
+// FlowDefinition は到達性を評価する通信経路
+// from/toにはセキュリティグループ名を指定する。protocolを省略した場合はtcp
+type FlowDefinition struct {
+	From     string `yaml:"from"`
+	To       string `yaml:"to"`
+	Port     int    `yaml:"port"`
+	Protocol string `yaml:"protocol"`
 }
 
 type ResourceDefinition struct {
 	Type            string   `yaml:"type"`
 	Identifier      string   `yaml:"identifier"`
 	Name            string   `yaml:"name"`
+	Cluster         string   `yaml:"cluster"`
 	Required        bool     `yaml:"required"`
 	ValidationRules []string `yaml:"validation_rules"`
 }
@@ -28,6 +47,35 @@ type ValidationRule struct {
 	Property     string      `yaml:"property"`
 	Expected     interface{} `yaml:"expected"`
 	Operator     string      `yaml:"operator"`
-	ErrorMessage string      `yaml:"error_message"`
+	ErrorMessage Text        `yaml:"error_message"`
+	Suggestion   Text        `yaml:"suggestion"`
+	DocumentRef  string      `yaml:"document_ref"`
 	Severity     string      `yaml:"severity"`
 }
+
+// Text は言語ごとに書き分けられるテキスト
+// YAMLでは文字列か、言語をキーにしたマップ（{en: ..., ja: ...}）で指定する
+type Text map[string]string
+
+// UnmarshalYAML は文字列の場合は言語を指定しないテキストとして読み込む
+func (t *Text) UnmarshalYAML(node *yaml.Node) error {
+	switch node.Kind {
+	case yaml.ScalarNode:
+		*t = Text{"": node.Value}
+		return nil
+	case yaml.MappingNode:
+		texts := map[string]string{}
+		if err := node.Decode(&texts); err != nil {
+			return err
+		}
+		*t = texts
+		return nil
+	default:
+		return fmt.Errorf("line %d: text must be a string or a map of language to string", node.Line)
+	}
+}
+
+// String は現在の言語のテキストを返す
+func (t Text) String() string {
+	return i18n.Localize(t)
+}
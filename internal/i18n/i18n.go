@@ -0,0 +1,100 @@
+package i18n
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+// Lang はメッセージの言語
+type Lang string
+
+const (
+	English  Lang = "en"
+	Japanese Lang = "ja"
+)
+
+var current = English
+
+// SetLang は以降のメッセージの言語を設定する
+func SetLang(lang Lang) {
+	current = lang
+}
+
+// Current は現在の言語を返す
+func Current() Lang {
+	return current
+}
+
+// ParseLang は --lang で指定された言語を解釈する
+func ParseLang(value string) (Lang, error) {
+	switch strings.ToLower(value) {
+	case "ja":
+		return Japanese, nil
+	case "en":
+		return English, nil
+	default:
+		return "", fmt.Errorf("unsupported language: %s (available: ja, en)", value)
+	}
+}
+
+// DetectLang は環境変数 LC_ALL、LC_MESSAGES、LANG の順に言語を判定する
+// 最初に見つかった値が ja で始まる場合は日本語、それ以外は英語
+func DetectLang() Lang {
+	for _, name := range []string{"LC_ALL", "LC_MESSAGES", "LANG"} {
+		value := os.Getenv(name)
+		if value == "" {
+			continue
+		}
+		if strings.HasPrefix(strings.ToLower(value), "ja") {
+			return Japanese
+		}
+		return English
+	}
+	return English
+}
+
+// T はキーに対応するメッセージを現在の言語で返す。argsがある場合はfmt.Sprintfで埋め込む
+// 現在の言語のメッセージがない場合は英語、キーが登録されていない場合はキーをそのまま使う
+func T(key string, args ...interface{}) string {
+	format := key
+	if texts, ok := messages[key]; ok {
+		if text, ok := texts[current]; ok {
+			format = text
+		} else if text, ok := texts[English]; ok {
+			format = text
+		}
+	}
+
+	if len(args) == 0 {
+		return format
+	}
+	return fmt.Sprintf(format, args...)
+}
+
+// Errorf はキーに対応するメッセージを現在の言語のエラーにする
+func Errorf(key string, args ...interface{}) error {
+	return errors.New(T(key, args...))
+}
+
+// Localize は言語ごとのテキストから現在の言語のものを選ぶ
+// 現在の言語がない場合は英語、言語を指定しないテキスト（キーが空）、その他の言語の順に使う
+func Localize(texts map[string]string) string {
+	for _, lang := range []string{string(current), string(English), ""} {
+		if text, ok := texts[lang]; ok {
+			return text
+		}
+	}
+
+	langs := make([]string, 0, len(texts))
+	for lang := range texts {
+		langs = append(langs, lang)
+	}
+	sort.Strings(langs)
+	if len(langs) > 0 {
+		return texts[langs[0]]
+	}
+	return ""
+}
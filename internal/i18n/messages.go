@@ -0,0 +1,573 @@
+package i18n
+
+// messages はメッセージカタログ。キーは「パッケージやファイル.内容」の形式にする
+// 引数の順序が言語によって変わる場合は %[1]s のように位置を指定する
+var messages = map[string]map[Lang]string{
+	// 検証エンジン
+	"engine.stack_not_found": {
+		English:  "CloudFormation stack '%s' not found",
+		Japanese: "CloudFormationスタック '%s' が見つかりません",
+	},
+	"engine.stack_not_found.suggestion": {
+		English:  "Please create the stack '%s' as described in the handbook",
+		Japanese: "ハンズオンの手順に従ってスタック '%s' を作成してください",
+	},
+	"engine.resource_not_found": {
+		English:  "Required resource '%s' not found",
+		Japanese: "必須リソース '%s' が見つかりません",
+	},
+	"engine.resource_not_found.suggestion": {
+		English:  "Please create the resource '%s' as described in step %d",
+		Japanese: "ステップ%[2]dの手順に従ってリソース '%[1]s' を作成してください",
+	},
+	"engine.step_ref": {
+		English:  "Step %d",
+		Japanese: "ステップ%d",
+	},
+	"engine.drift_failed": {
+		English:  "Failed to detect drift: %v",
+		Japanese: "ドリフトを検出できませんでした: %v",
+	},
+	"engine.drift_timeout": {
+		English:  "Drift detection did not finish within %v. Increase --drift-timeout and try again",
+		Japanese: "ドリフト検出が%vで完了しませんでした。--drift-timeout を長くして再実行してください",
+	},
+	"engine.drifted": {
+		English:  "Resource has drifted from the CloudFormation template (%s)",
+		Japanese: "リソースがCloudFormationテンプレートからドリフトしています（%s）",
+	},
+	"engine.check_failed": {
+		English:  "Failed to check resource: %v",
+		Japanese: "リソースを確認できませんでした: %v",
+	},
+	"engine.flow_failed": {
+		English:  "Failed to evaluate flow %s: %s",
+		Japanese: "通信経路 %s を評価できませんでした: %s",
+	},
+	"engine.flow_failed.suggestion": {
+		English:  "Please make sure the security groups in the flow exist",
+		Japanese: "通信経路のセキュリティグループが存在することを確認してください",
+	},
+	"engine.flow_indeterminate": {
+		English:  "Reachability of %s could not be determined: %s",
+		Japanese: "通信 %s の到達性を判定できませんでした: %s",
+	},
+	"engine.flow_blocked": {
+		English:  "Traffic %s is blocked at %s: %s",
+		Japanese: "通信 %s が %s で遮断されています: %s",
+	},
+	"engine.flow_blocked.suggestion": {
+		English:  "Please review the security group, network ACL and route table rules on the path",
+		Japanese: "経路上のセキュリティグループ、ネットワークACL、ルートテーブルのルールを見直してください",
+	},
+
+	// 検証ルールの評価
+	"rule.property_not_found": {
+		English:  "%s: property '%s' not found",
+		Japanese: "%s: プロパティ '%s' がありません",
+	},
+	"rule.expected": {
+		English:  "%s: expected %v, got %v",
+		Japanese: "%s: 期待値 %v、実際の値 %v",
+	},
+	"rule.ne": {
+		English:  "%s: value should not be %v",
+		Japanese: "%s: 値が %v であってはいけません",
+	},
+	"rule.gt": {
+		English:  "%s: %v should be greater than %v",
+		Japanese: "%s: %v は %v より大きい必要があります",
+	},
+	"rule.lt": {
+		English:  "%s: %v should be less than %v",
+		Japanese: "%s: %v は %v より小さい必要があります",
+	},
+	"rule.ge": {
+		English:  "%s: %v should be greater than or equal to %v",
+		Japanese: "%s: %v は %v 以上である必要があります",
+	},
+	"rule.le": {
+		English:  "%s: %v should be less than or equal to %v",
+		Japanese: "%s: %v は %v 以下である必要があります",
+	},
+	"rule.contains": {
+		English:  "%s: %v should contain %v",
+		Japanese: "%s: %v に %v が含まれている必要があります",
+	},
+	"rule.regex": {
+		English:  "%s: %v does not match pattern %v",
+		Japanese: "%s: %v がパターン %v に一致しません",
+	},
+	"rule.starts_with": {
+		English:  "%s: %v should start with %v",
+		Japanese: "%s: %v は %v で始まる必要があります",
+	},
+	"rule.count_expected": {
+		English:  "%s: expected count %d, got %d",
+		Japanese: "%s: 期待する件数 %d、実際の件数 %d",
+	},
+	"rule.count_ne": {
+		English:  "%s: count should not be %d",
+		Japanese: "%s: 件数が %d であってはいけません",
+	},
+	"rule.count_gt": {
+		English:  "%s: count %d should be greater than %d",
+		Japanese: "%s: 件数 %d は %d より大きい必要があります",
+	},
+	"rule.count_lt": {
+		English:  "%s: count %d should be less than %d",
+		Japanese: "%s: 件数 %d は %d より小さい必要があります",
+	},
+	"rule.count_ge": {
+		English:  "%s: count %d should be greater than or equal to %d",
+		Japanese: "%s: 件数 %d は %d 以上である必要があります",
+	},
+	"rule.count_le": {
+		English:  "%s: count %d should be less than or equal to %d",
+		Japanese: "%s: 件数 %d は %d 以下である必要があります",
+	},
+	"rule.not_allowed": {
+		English:  "%s: %s is not allowed on %s",
+		Japanese: "%s: %[3]s に対して %[2]s が許可されていません",
+	},
+
+	// Cloud Control APIによる検索
+	"cloudcontrol.lookup_limit": {
+		English:  "%s '%s' was not found in the first %d resources whose details were fetched; set identifier in the step definition",
+		Japanese: "%s '%s' は詳細を取得した最初の %d 件のリソースに見つかりませんでした。ステップ定義に identifier を指定してください",
+	},
+
+	// 通信経路の到達性
+	"flow.sg_not_found": {
+		English:  "security group '%s' not found",
+		Japanese: "セキュリティグループ '%s' が見つかりません",
+	},
+	"flow.no_interfaces": {
+		English:  "reachability could not be determined because no network interfaces use the security groups; CIDR rules, network ACLs and route tables were not evaluated",
+		Japanese: "セキュリティグループを使用しているネットワークインターフェースがないため到達性を判定できません。CIDRのルール、ネットワークACL、ルートテーブルは評価していません",
+	},
+	"flow.sg_allowed_group": {
+		English:  "allowed by rule referencing %s",
+		Japanese: "%s を参照するルールで許可",
+	},
+	"flow.sg_maybe_allowed_cidr": {
+		English:  "may be allowed by rule for %s (the peer IP is unknown)",
+		Japanese: "%s に対するルールで許可される可能性があります（相手のIPが不明）",
+	},
+	"flow.sg_allowed_cidr": {
+		English:  "allowed by rule for %s",
+		Japanese: "%s に対するルールで許可",
+	},
+	"flow.sg_denied": {
+		English:  "no %s rule allows %s/%d for %s",
+		Japanese: "%[4]s に対して %[2]s/%[3]d を許可する%[1]sルールがありません",
+	},
+	"flow.sg_prefix_lists": {
+		English:  " (prefix list rules are not evaluated)",
+		Japanese: "（プレフィックスリストのルールは評価していません）",
+	},
+	"flow.acl_allowed": {
+		English:  "allowed by rule %s (%s)",
+		Japanese: "ルール %s（%s）で許可",
+	},
+	"flow.acl_denied": {
+		English:  "%s/%d denied by rule %s (%s)",
+		Japanese: "ルール %[3]s（%[4]s）で %[1]s/%[2]d を拒否",
+	},
+	"flow.acl_no_match": {
+		English:  "no rule matches %s/%d for %s",
+		Japanese: "%[3]s の %[1]s/%[2]d に一致するルールがありません",
+	},
+	"flow.no_route": {
+		English:  "no route to %s",
+		Japanese: "%s へのルートがありません",
+	},
+	"flow.blackhole": {
+		English:  "route %s is blackhole",
+		Japanese: "ルート %s がブラックホールになっています",
+	},
+	"flow.routed": {
+		English:  "routed via %s (%s)",
+		Japanese: "%s 経由でルーティング（%s）",
+	},
+
+	// 状態の表示
+	"status.passed": {
+		English:  "PASSED",
+		Japanese: "成功",
+	},
+	"status.failed": {
+		English:  "FAILED",
+		Japanese: "失敗",
+	},
+	"status.warning": {
+		English:  "WARNING",
+		Japanese: "警告",
+	},
+	"status.skipped": {
+		English:  "SKIPPED",
+		Japanese: "スキップ",
+	},
+	"status.pending": {
+		English:  "PENDING",
+		Japanese: "未実行",
+	},
+	"status.exists": {
+		English:  "EXISTS",
+		Japanese: "存在",
+	},
+	"status.not_found": {
+		English:  "NOT_FOUND",
+		Japanese: "なし",
+	},
+	"status.misconfigured": {
+		English:  "MISCONFIGURED",
+		Japanese: "設定誤り",
+	},
+	"status.pass": {
+		English:  "pass",
+		Japanese: "成功",
+	},
+	"status.fail": {
+		English:  "fail",
+		Japanese: "失敗",
+	},
+
+	// コンソール出力
+	"console.summary_title": {
+		English:  "VALIDATION SUMMARY REPORT",
+		Japanese: "検証結果サマリー",
+	},
+	"console.total_steps": {
+		English:  "Total Steps: %d",
+		Japanese: "ステップ数: %d",
+	},
+	"console.passed_count": {
+		English:  "Passed: %d",
+		Japanese: "成功: %d",
+	},
+	"console.failed_count": {
+		English:  "Failed: %d",
+		Japanese: "失敗: %d",
+	},
+	"console.skipped_count": {
+		English:  "Skipped: %d",
+		Japanese: "スキップ: %d",
+	},
+	"console.step_header": {
+		English:  "STEP %d: %s",
+		Japanese: "ステップ %d: %s",
+	},
+	"console.status": {
+		English:  "Status: %s",
+		Japanese: "状態: %s",
+	},
+	"console.duration": {
+		English:  "Duration: %v",
+		Japanese: "所要時間: %v",
+	},
+	"console.resources": {
+		English:  "Resources Checked:",
+		Japanese: "確認したリソース:",
+	},
+	"console.stack": {
+		English:  "Stack: %s/%s",
+		Japanese: "スタック: %s/%s",
+	},
+	"console.property": {
+		English:  "property:",
+		Japanese: "プロパティ:",
+	},
+	"console.expected": {
+		English:  "expected:",
+		Japanese: "期待値:",
+	},
+	"console.actual": {
+		English:  "actual:",
+		Japanese: "実際の値:",
+	},
+	"console.properties": {
+		English:  "Properties:",
+		Japanese: "取得したプロパティ:",
+	},
+	"console.api_response": {
+		English:  "API response: %s %s",
+		Japanese: "APIレスポンス: %s %s",
+	},
+	"console.errors": {
+		English:  "Errors:",
+		Japanese: "エラー:",
+	},
+	"console.suggestion": {
+		English:  "Suggestion: ",
+		Japanese: "対処方法: ",
+	},
+	"console.reference": {
+		English:  "Reference: ",
+		Japanese: "参照: ",
+	},
+	"console.warnings": {
+		English:  "Warnings:",
+		Japanese: "警告:",
+	},
+	"console.drift": {
+		English:  "CloudFormation Drift:",
+		Japanese: "CloudFormationのドリフト:",
+	},
+	"console.drift_difference": {
+		English:  "%s %s: expected %s, got %s",
+		Japanese: "%s %s: 期待値 %s、実際の値 %s",
+	},
+	"console.reachability": {
+		English:  "Reachability:",
+		Japanese: "通信経路の到達性:",
+	},
+	"console.blocked_at": {
+		English:  "blocked at %s: %s",
+		Japanese: "%s で遮断: %s",
+	},
+	"console.blocked_at_short": {
+		English:  "blocked at %s",
+		Japanese: "%s で遮断",
+	},
+	"console.step_passed": {
+		English:  "All checks passed! You can proceed to the next step.",
+		Japanese: "すべての確認に成功しました。次のステップに進めます。",
+	},
+	"console.step_warning": {
+		English:  "Validation completed with warnings. Please review the warnings above.",
+		Japanese: "警告があります。上の警告を確認してください。",
+	},
+	"console.step_failed": {
+		English:  "Validation failed. Please fix the errors above before proceeding.",
+		Japanese: "検証に失敗しました。次に進む前に上のエラーを修正してください。",
+	},
+	"console.step_skipped": {
+		English:  "Validation was skipped.",
+		Japanese: "検証をスキップしました。",
+	},
+	"console.step": {
+		English:  "Step %d",
+		Japanese: "ステップ %d",
+	},
+	"console.all_passed": {
+		English:  "Congratulations! All steps validated successfully!",
+		Japanese: "おめでとうございます！すべてのステップの検証に成功しました！",
+	},
+	"console.all_passed_detail": {
+		English:  "Your hands-on environment is correctly configured.",
+		Japanese: "ハンズオン環境は正しく構成されています。",
+	},
+	"console.steps_failed": {
+		English:  "%d step(s) failed validation.",
+		Japanese: "%d 個のステップの検証に失敗しました。",
+	},
+	"console.steps_failed_detail": {
+		English:  "Please review and fix the errors before proceeding.",
+		Japanese: "次に進む前にエラーを確認して修正してください。",
+	},
+	"console.steps_skipped": {
+		English:  "Some steps were skipped.",
+		Japanese: "スキップしたステップがあります。",
+	},
+	"console.steps_skipped_detail": {
+		English:  "Run individual step validations for more details.",
+		Japanese: "詳細はステップごとに検証を実行して確認してください。",
+	},
+	"console.error": {
+		English:  "Error: %v",
+		Japanese: "エラー: %v",
+	},
+
+	// Markdown / HTMLレポート
+	"report.title": {
+		English:  "SBCNTR Validation Report",
+		Japanese: "SBCNTR 検証レポート",
+	},
+	"report.generated_at": {
+		English:  "Generated at %s",
+		Japanese: "%s に作成",
+	},
+	"report.summary": {
+		English:  "Summary",
+		Japanese: "サマリー",
+	},
+	"report.total": {
+		English:  "Total",
+		Japanese: "合計",
+	},
+	"report.passed": {
+		English:  "Passed",
+		Japanese: "成功",
+	},
+	"report.failed": {
+		English:  "Failed",
+		Japanese: "失敗",
+	},
+	"report.skipped": {
+		English:  "Skipped",
+		Japanese: "スキップ",
+	},
+	"report.step": {
+		English:  "Step",
+		Japanese: "ステップ",
+	},
+	"report.step_title": {
+		English:  "Step %d: %s",
+		Japanese: "ステップ %d: %s",
+	},
+	"report.name": {
+		English:  "Name",
+		Japanese: "名前",
+	},
+	"report.status": {
+		English:  "Status",
+		Japanese: "状態",
+	},
+	"report.duration": {
+		English:  "Duration",
+		Japanese: "所要時間",
+	},
+	"report.resources": {
+		English:  "Resources",
+		Japanese: "リソース",
+	},
+	"report.type": {
+		English:  "Type",
+		Japanese: "タイプ",
+	},
+	"report.stack": {
+		English:  "Stack",
+		Japanese: "スタック",
+	},
+	"report.rules": {
+		English:  "Rules",
+		Japanese: "検証ルール",
+	},
+	"report.result": {
+		English:  "Result",
+		Japanese: "結果",
+	},
+	"report.resource": {
+		English:  "Resource",
+		Japanese: "リソース",
+	},
+	"report.rule": {
+		English:  "Rule",
+		Japanese: "ルール",
+	},
+	"report.property": {
+		English:  "Property",
+		Japanese: "プロパティ",
+	},
+	"report.expected": {
+		English:  "Expected",
+		Japanese: "期待値",
+	},
+	"report.actual": {
+		English:  "Actual",
+		Japanese: "実際の値",
+	},
+	"report.message": {
+		English:  "Message",
+		Japanese: "メッセージ",
+	},
+	"report.errors": {
+		English:  "Errors",
+		Japanese: "エラー",
+	},
+	"report.suggestions": {
+		English:  "Suggestions",
+		Japanese: "対処方法",
+	},
+	"report.warnings": {
+		English:  "Warnings",
+		Japanese: "警告",
+	},
+	"report.reachability": {
+		English:  "Reachability",
+		Japanese: "通信経路の到達性",
+	},
+	"report.from": {
+		English:  "From",
+		Japanese: "送信元",
+	},
+	"report.to": {
+		English:  "To",
+		Japanese: "宛先",
+	},
+	"report.port": {
+		English:  "Port",
+		Japanese: "ポート",
+	},
+	"report.blocked_at": {
+		English:  "Blocked at",
+		Japanese: "遮断箇所",
+	},
+	"report.reason": {
+		English:  "Reason",
+		Japanese: "理由",
+	},
+	"report.drift": {
+		English:  "CloudFormation Drift",
+		Japanese: "CloudFormationのドリフト",
+	},
+	"report.logical_id": {
+		English:  "Logical ID",
+		Japanese: "論理ID",
+	},
+	"report.drift_status": {
+		English:  "Drift",
+		Japanese: "ドリフト",
+	},
+
+	// JUnit / SARIFレポート
+	"report.resource_not_found": {
+		English:  "Resource '%s' not found",
+		Japanese: "リソース '%s' が見つかりません",
+	},
+	"report.required_resource_not_found": {
+		English:  "Required resource '%s' (%s) not found",
+		Japanese: "必須リソース '%s'（%s）が見つかりません",
+	},
+	"report.step_skipped": {
+		English:  "validation was skipped",
+		Japanese: "検証をスキップしました",
+	},
+	"report.blocked_at_reason": {
+		English:  "blocked at %s: %s",
+		Japanese: "%s で遮断: %s",
+	},
+	"report.suggestion_line": {
+		English:  "Suggestion: %s",
+		Japanese: "対処方法: %s",
+	},
+	"report.reference_line": {
+		English:  "Reference: %s",
+		Japanese: "参照: %s",
+	},
+	"report.rule_resource_exists": {
+		English:  "Required resource must exist",
+		Japanese: "必須リソースが存在すること",
+	},
+	"report.rule_resource_exists.help": {
+		English:  "Create the resource as described in the handbook",
+		Japanese: "ハンズオンの手順に従ってリソースを作成してください",
+	},
+	"report.rule_flow_reachable": {
+		English:  "Declared network flow must be reachable",
+		Japanese: "定義した通信経路が到達可能であること",
+	},
+	"report.rule_flow_reachable.help": {
+		English:  "Review the security group, network ACL and route table rules on the path",
+		Japanese: "経路上のセキュリティグループ、ネットワークACL、ルートテーブルのルールを見直してください",
+	},
+	"report.rule_step_error": {
+		English:  "Step must be validated without errors",
+		Japanese: "ステップの検証でエラーが発生しないこと",
+	},
+	"report.rule_step_error.help": {
+		English:  "Follow the suggestion in the result properties",
+		Japanese: "結果のプロパティの修正方法（suggestion）に従ってください",
+	},
+}
@@ -1,44 +1,77 @@
 package reporter
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
 	"os"
+	"sbcntr2-test-tool/internal/i18n"
 	"sbcntr2-test-tool/internal/validator"
 	"strings"
+	"time"
 )
 
+// ConsoleReporter は端末向けに検証結果を出力する
+// 色・記号・折り返し幅・表示量は ConsoleOptions で切り替える
 type ConsoleReporter struct {
-	verbose bool
+	out     io.Writer
+	options ConsoleOptions
+	sym     consoleSymbols
 }
 
-func NewConsoleReporter(verbose bool) *ConsoleReporter {
+func NewConsoleReporter(out io.Writer, options ConsoleOptions) *ConsoleReporter {
+	sym := emojiSymbols
+	if options.ASCII {
+		sym = asciiSymbols
+	}
+
 	return &ConsoleReporter{
-		verbose: verbose,
+		out:     out,
+		options: options,
+		sym:     sym,
 	}
 }
 
 func (r *ConsoleReporter) ReportResult(result *validator.ValidationResult) error {
+	if r.options.Compact {
+		r.printCompactResult(result)
+		return nil
+	}
+
 	r.printHeader(result)
 	r.printResources(result.Resources)
 	r.printErrors(result.Errors)
 	r.printWarnings(result.Warnings)
+	r.printDrifts(result.Drifts)
+	r.printFlows(result.Flows)
 	r.printFooter(result)
 
 	return nil
 }
 
 func (r *ConsoleReporter) ReportSummary(summary *validator.ValidationSummary) error {
-	fmt.Println("\n╔══════════════════════════════════════════════════════╗")
-	fmt.Println("║            VALIDATION SUMMARY REPORT                 ║")
-	fmt.Println("╚══════════════════════════════════════════════════════╝\n")
+	if r.options.Compact {
+		for _, result := range summary.Results {
+			r.printCompactResult(&result)
+		}
+		r.printOverallStatus(summary)
+		return nil
+	}
+
+	r.printBox(i18n.T("console.summary_title"), 54)
 
-	fmt.Printf("Total Steps: %d\n", summary.TotalSteps)
-	fmt.Printf("✅ Passed: %d\n", summary.PassedSteps)
-	fmt.Printf("❌ Failed: %d\n", summary.FailedSteps)
-	fmt.Printf("⏭️  Skipped: %d\n\n", summary.SkippedSteps)
+	fmt.Fprintln(r.out, i18n.T("console.total_steps", summary.TotalSteps))
+	fmt.Fprintln(r.out, r.paint(ansiGreen, r.sym.passed), i18n.T("console.passed_count", summary.PassedSteps))
+	fmt.Fprintln(r.out, r.paint(ansiRed, r.sym.failed), i18n.T("console.failed_count", summary.FailedSteps))
+	fmt.Fprint(r.out, r.paint(ansiGray, r.sym.skipped)+" "+i18n.T("console.skipped_count", summary.SkippedSteps)+"\n\n")
 
+	statusWidth, nameWidth := 0, 0
 	for _, result := range summary.Results {
-		r.printSummaryStep(&result)
+		statusWidth = max(statusWidth, displayWidth(r.statusText(result.Status)))
+		nameWidth = max(nameWidth, displayWidth(result.StepName))
+	}
+	for _, result := range summary.Results {
+		r.printSummaryStep(&result, statusWidth, nameWidth)
 	}
 
 	r.printOverallStatus(summary)
@@ -47,11 +80,25 @@ func (r *ConsoleReporter) ReportSummary(summary *validator.ValidationSummary) er
 }
 
 func (r *ConsoleReporter) printHeader(result *validator.ValidationResult) {
-	fmt.Println("\n" + strings.Repeat("=", 60))
-	fmt.Printf("STEP %d: %s\n", result.StepNumber, result.StepName)
-	fmt.Println(strings.Repeat("=", 60))
-	fmt.Printf("Status: %s\n", r.getStatusIcon(result.Status))
-	fmt.Printf("Duration: %v\n\n", result.Duration)
+	fmt.Fprintln(r.out, "\n"+r.separator(r.sym.heavyLine, 60))
+	fmt.Fprintln(r.out, r.paint(ansiBold, i18n.T("console.step_header", result.StepNumber, result.StepName)))
+	fmt.Fprintln(r.out, r.separator(r.sym.heavyLine, 60))
+	fmt.Fprintln(r.out, i18n.T("console.status", r.getStatusIcon(result.Status)))
+	fmt.Fprint(r.out, i18n.T("console.duration", result.Duration)+"\n\n")
+}
+
+// printBox は見出しを枠で囲んで表示する
+func (r *ConsoleReporter) printBox(title string, width int) {
+	top := []rune(r.sym.boxTop)
+	bottom := []rune(r.sym.boxBottom)
+	horizontal := strings.Repeat(string(top[1]), width)
+	padding := max(width-displayWidth(title), 0)
+	left := padding / 2
+
+	fmt.Fprintln(r.out)
+	fmt.Fprintln(r.out, string(top[0])+horizontal+string(top[2]))
+	fmt.Fprintln(r.out, r.sym.boxSide+strings.Repeat(" ", left)+r.paint(ansiBold, title)+strings.Repeat(" ", padding-left)+r.sym.boxSide)
+	fmt.Fprint(r.out, string(bottom[0])+horizontal+string(bottom[2])+"\n\n")
 }
 
 func (r *ConsoleReporter) printResources(resources []validator.ResourceResult) {
@@ -59,24 +106,84 @@ func (r *ConsoleReporter) printResources(resources []validator.ResourceResult) {
 		return
 	}
 
-	fmt.Println("Resources Checked:")
-	fmt.Println(strings.Repeat("-", 40))
+	fmt.Fprintln(r.out, i18n.T("console.resources"))
+	fmt.Fprintln(r.out, r.separator(r.sym.lightLine, 40))
 
 	for _, resource := range resources {
-		icon := r.getResourceStatusIcon(resource.Status)
-		fmt.Printf("%s %s (%s)\n", icon, resource.Name, resource.Type)
+		r.printWrapped(r.getResourceStatusIcon(resource.Status)+" ", fmt.Sprintf("%s (%s)", resource.Name, resource.Type))
 
-		// Verbose output disabled to keep output clean
+		if r.options.Verbosity > 0 {
+			r.printResourceDetails(resource)
+			continue
+		}
 
 		for _, err := range resource.Errors {
-			fmt.Printf("  ❌ %s\n", err)
+			r.printWrapped("  "+r.paint(ansiRed, r.sym.failed)+" ", err)
 		}
 
 		for _, warn := range resource.Warnings {
-			fmt.Printf("  ⚠️  %s\n", warn)
+			r.printWrapped("  "+r.paint(ansiYellow, r.sym.warning)+" ", warn)
+		}
+	}
+	fmt.Fprintln(r.out)
+}
+
+// printResourceDetails は成功したものも含めて全検証ルールの結果と、取得したプロパティを表示する
+func (r *ConsoleReporter) printResourceDetails(resource validator.ResourceResult) {
+	if resource.ID != "" {
+		fmt.Fprintf(r.out, "  ID: %s\n", resource.ID)
+	}
+	if resource.StackName != "" {
+		fmt.Fprintln(r.out, "  "+i18n.T("console.stack", resource.StackName, resource.LogicalID))
+	}
+
+	// 存在確認に失敗した場合のエラー（検証ルールのエラーは下のルール一覧に表示する）
+	if len(resource.Rules) == 0 {
+		for _, err := range resource.Errors {
+			r.printWrapped("  "+r.paint(ansiRed, r.sym.failed)+" ", err)
+		}
+	}
+
+	labelWidth := 0
+	for _, key := range []string{"console.property", "console.expected", "console.actual"} {
+		labelWidth = max(labelWidth, displayWidth(i18n.T(key)))
+	}
+	label := func(key string) string {
+		return "      " + padRight(i18n.T(key), labelWidth) + " "
+	}
+
+	for _, rule := range resource.Rules {
+		icon := r.paint(ansiGreen, r.sym.check)
+		if !rule.Passed && rule.Severity == "error" {
+			icon = r.paint(ansiRed, r.sym.cross)
+		} else if !rule.Passed {
+			icon = r.paint(ansiYellow, r.sym.cross)
+		}
+		fmt.Fprintf(r.out, "  %s %s [%s]\n", icon, rule.Name, rule.Severity)
+		fmt.Fprintln(r.out, label("console.property")+rule.Property)
+		if expected := ruleExpectation(rule); expected != "" {
+			r.printWrapped(label("console.expected"), expected)
+		}
+		r.printWrapped(label("console.actual"), formatValue(rule.Actual))
+		if !rule.Passed {
+			r.printWrapped("      ", rule.Message)
+			if rule.Suggestion != "" {
+				r.printWrapped("      "+r.sym.suggestion+" ", rule.Suggestion)
+			}
+		}
+	}
+
+	if resource.Status != validator.ResourceNotFound && len(resource.Actual) > 0 {
+		fmt.Fprintln(r.out, "  "+i18n.T("console.properties"))
+		fmt.Fprintln(r.out, indentLines(prettyJSON(resource.Actual), 4))
+	}
+
+	if r.options.Verbosity > 1 {
+		for _, call := range resource.APICalls {
+			fmt.Fprintln(r.out, "  "+i18n.T("console.api_response", call.Service, call.Operation))
+			fmt.Fprintln(r.out, indentLines(prettyJSON(call.Response), 4))
 		}
 	}
-	fmt.Println()
 }
 
 func (r *ConsoleReporter) printErrors(errors []validator.ValidationError) {
@@ -84,18 +191,18 @@ func (r *ConsoleReporter) printErrors(errors []validator.ValidationError) {
 		return
 	}
 
-	fmt.Println("❌ Errors:")
-	fmt.Println(strings.Repeat("-", 40))
+	fmt.Fprintln(r.out, r.paint(ansiRed, r.sym.failed+" "+i18n.T("console.errors")))
+	fmt.Fprintln(r.out, r.separator(r.sym.lightLine, 40))
 
 	for _, err := range errors {
-		fmt.Printf("• %s\n", err.Message)
+		r.printWrapped(r.sym.bullet+" ", err.Message)
 		if err.Suggestion != "" {
-			fmt.Printf("  💡 Suggestion: %s\n", err.Suggestion)
+			r.printWrapped("  "+r.sym.suggestion+" "+i18n.T("console.suggestion"), err.Suggestion)
 		}
 		if err.DocumentRef != "" {
-			fmt.Printf("  📖 Reference: %s\n", err.DocumentRef)
+			r.printWrapped("  "+r.sym.reference+" "+i18n.T("console.reference"), err.DocumentRef)
 		}
-		fmt.Println()
+		fmt.Fprintln(r.out)
 	}
 }
 
@@ -104,87 +211,296 @@ func (r *ConsoleReporter) printWarnings(warnings []validator.ValidationWarning)
 		return
 	}
 
-	fmt.Println("⚠️  Warnings:")
-	fmt.Println(strings.Repeat("-", 40))
+	fmt.Fprintln(r.out, r.paint(ansiYellow, r.sym.warning+" "+i18n.T("console.warnings")))
+	fmt.Fprintln(r.out, r.separator(r.sym.lightLine, 40))
 
 	for _, warn := range warnings {
-		fmt.Printf("• %s: %s\n", warn.Resource, warn.Message)
+		r.printWrapped(r.sym.bullet+" ", fmt.Sprintf("%s: %s", warn.Resource, warn.Message))
+	}
+	fmt.Fprintln(r.out)
+}
+
+func (r *ConsoleReporter) printDrifts(drifts []validator.StackDrift) {
+	if len(drifts) == 0 {
+		return
 	}
-	fmt.Println()
+
+	fmt.Fprintln(r.out, r.sym.drift+" "+i18n.T("console.drift"))
+	fmt.Fprintln(r.out, r.separator(r.sym.lightLine, 40))
+
+	for _, drift := range drifts {
+		fmt.Fprintf(r.out, "%s %s: %s\n", r.sym.bullet, drift.StackName, drift.DriftStatus)
+		for _, res := range drift.Resources {
+			if res.DriftStatus == "IN_SYNC" {
+				continue
+			}
+			fmt.Fprintf(r.out, "  %s (%s): %s\n", res.LogicalID, res.Type, res.DriftStatus)
+			for _, diff := range res.PropertyDifferences {
+				r.printWrapped("    ", i18n.T("console.drift_difference", diff.DifferenceType, diff.PropertyPath, diff.ExpectedValue, diff.ActualValue))
+			}
+		}
+	}
+	fmt.Fprintln(r.out)
+}
+
+func (r *ConsoleReporter) printFlows(flows []validator.FlowResult) {
+	if len(flows) == 0 {
+		return
+	}
+
+	fmt.Fprintln(r.out, r.sym.flow+" "+i18n.T("console.reachability"))
+	fmt.Fprintln(r.out, r.separator(r.sym.lightLine, 40))
+
+	for _, flow := range flows {
+		fmt.Fprintf(r.out, "%s %s -> %s (%s/%d)\n", r.flowIcon(flow), flow.From, flow.To, flow.Protocol, flow.Port)
+		if !flow.Reachable && flow.BlockingHop != "" {
+			r.printWrapped("  ", i18n.T("console.blocked_at", flow.BlockingHop, flow.Reason))
+		} else if flow.Reason != "" {
+			r.printWrapped("  ", flow.Reason)
+		}
+
+		if r.options.Verbosity > 0 {
+			for _, hop := range flow.Hops {
+				hopIcon := r.paint(ansiGreen, r.sym.check)
+				if !hop.Allowed {
+					hopIcon = r.paint(ansiRed, r.sym.cross)
+				}
+				r.printWrapped("    "+hopIcon+" ", fmt.Sprintf("%s: %s", hop.Name, hop.Detail))
+			}
+		}
+	}
+	fmt.Fprintln(r.out)
 }
 
 func (r *ConsoleReporter) printFooter(result *validator.ValidationResult) {
-	fmt.Println(strings.Repeat("=", 60))
+	fmt.Fprintln(r.out, r.separator(r.sym.heavyLine, 60))
 
 	switch result.Status {
 	case validator.StatusPassed:
-		fmt.Println("✅ All checks passed! You can proceed to the next step.")
+		fmt.Fprintln(r.out, r.paint(ansiGreen, r.sym.passed+" "+i18n.T("console.step_passed")))
 	case validator.StatusWarning:
-		fmt.Println("⚠️  Validation completed with warnings. Please review the warnings above.")
+		fmt.Fprintln(r.out, r.paint(ansiYellow, r.sym.warning+" "+i18n.T("console.step_warning")))
 	case validator.StatusFailed:
-		fmt.Println("❌ Validation failed. Please fix the errors above before proceeding.")
+		fmt.Fprintln(r.out, r.paint(ansiRed, r.sym.failed+" "+i18n.T("console.step_failed")))
 	case validator.StatusSkipped:
-		fmt.Println("⏭️  Validation was skipped.")
+		fmt.Fprintln(r.out, r.paint(ansiGray, r.sym.skipped+" "+i18n.T("console.step_skipped")))
+	}
+	fmt.Fprintln(r.out)
+}
+
+// printCompactResult はステップを1行、リソースと通信経路を1行ずつ表示する
+// 失敗した検証ルールは最初の1件のメッセージだけを端末の幅に収めて表示する
+func (r *ConsoleReporter) printCompactResult(result *validator.ValidationResult) {
+	fmt.Fprintf(r.out, "%s %s: %s (%s)\n", r.getStatusIcon(result.Status), i18n.T("console.step", result.StepNumber), result.StepName, result.Duration.Round(time.Millisecond))
+
+	nameWidth := 0
+	for _, resource := range result.Resources {
+		nameWidth = max(nameWidth, displayWidth(resource.Name))
+	}
+
+	for _, resource := range result.Resources {
+		passed := 0
+		failure := ""
+		for _, rule := range resource.Rules {
+			if rule.Passed {
+				passed++
+			} else if failure == "" {
+				failure = rule.Message
+			}
+		}
+		if failure == "" && len(resource.Errors) > 0 {
+			failure = resource.Errors[0]
+		}
+
+		columns := fmt.Sprintf("%s %5s", padRight(resource.Name, nameWidth), fmt.Sprintf("%d/%d", passed, len(resource.Rules)))
+		line := fmt.Sprintf("  %s %s", r.getResourceStatusIcon(resource.Status), columns)
+		if failure != "" {
+			used := displayWidth(fmt.Sprintf("  %s %s ", r.resourceSymbol(resource.Status), columns))
+			width := 0
+			if r.options.Width > 0 {
+				width = max(r.options.Width-used, 10)
+			}
+			line += " " + truncate(failure, width)
+		}
+		fmt.Fprintln(r.out, line)
+	}
+
+	for _, flow := range result.Flows {
+		line := fmt.Sprintf("  %s %s -> %s (%s/%d)", r.flowIcon(flow), flow.From, flow.To, flow.Protocol, flow.Port)
+		if !flow.Reachable && flow.BlockingHop != "" {
+			line += " " + i18n.T("console.blocked_at_short", flow.BlockingHop)
+		}
+		fmt.Fprintln(r.out, line)
 	}
-	fmt.Println()
 }
 
-func (r *ConsoleReporter) printSummaryStep(result *validator.ValidationResult) {
-	icon := r.getStatusIcon(result.Status)
-	fmt.Printf("%s Step %d: %s\n", icon, result.StepNumber, result.StepName)
+// printSummaryStep はステップの結果を、状態・ステップ番号・名前・所要時間の列を揃えて表示する
+func (r *ConsoleReporter) printSummaryStep(result *validator.ValidationResult, statusWidth, nameWidth int) {
+	padding := strings.Repeat(" ", max(statusWidth-displayWidth(r.statusText(result.Status)), 0))
+	fmt.Fprintf(r.out, "%s%s  %s  %s  %8s\n", r.getStatusIcon(result.Status), padding, i18n.T("console.step", result.StepNumber),
+		padRight(result.StepName, nameWidth), result.Duration.Round(time.Millisecond))
 
 	if result.Status == validator.StatusFailed && len(result.Errors) > 0 {
 		for _, err := range result.Errors {
-			fmt.Printf("   - %s\n", err.Message)
+			r.printWrapped("   - ", err.Message)
 		}
 	}
 }
 
 func (r *ConsoleReporter) printOverallStatus(summary *validator.ValidationSummary) {
-	fmt.Println("\n" + strings.Repeat("=", 60))
+	fmt.Fprintln(r.out, "\n"+r.separator(r.sym.heavyLine, 60))
 
 	if summary.FailedSteps == 0 && summary.SkippedSteps == 0 {
-		fmt.Println("🎉 Congratulations! All steps validated successfully!")
-		fmt.Println("Your hands-on environment is correctly configured.")
+		fmt.Fprintln(r.out, r.paint(ansiGreen, r.sym.celebrate+" "+i18n.T("console.all_passed")))
+		fmt.Fprintln(r.out, i18n.T("console.all_passed_detail"))
 	} else if summary.FailedSteps > 0 {
-		fmt.Printf("❌ %d step(s) failed validation.\n", summary.FailedSteps)
-		fmt.Println("Please review and fix the errors before proceeding.")
+		fmt.Fprintln(r.out, r.paint(ansiRed, r.sym.failed+" "+i18n.T("console.steps_failed", summary.FailedSteps)))
+		fmt.Fprintln(r.out, i18n.T("console.steps_failed_detail"))
 	} else {
-		fmt.Println("⚠️  Some steps were skipped.")
-		fmt.Println("Run individual step validations for more details.")
+		fmt.Fprintln(r.out, r.paint(ansiYellow, r.sym.warning+" "+i18n.T("console.steps_skipped")))
+		fmt.Fprintln(r.out, i18n.T("console.steps_skipped_detail"))
 	}
 
-	fmt.Println(strings.Repeat("=", 60))
+	fmt.Fprintln(r.out, r.separator(r.sym.heavyLine, 60))
 }
 
 func (r *ConsoleReporter) getStatusIcon(status validator.ValidationStatus) string {
+	text := r.statusText(status)
 	switch status {
 	case validator.StatusPassed:
-		return "✅ PASSED"
+		return r.paint(ansiGreen, text)
 	case validator.StatusFailed:
-		return "❌ FAILED"
+		return r.paint(ansiRed, text)
 	case validator.StatusWarning:
-		return "⚠️  WARNING"
+		return r.paint(ansiYellow, text)
+	default:
+		return r.paint(ansiGray, text)
+	}
+}
+
+// statusText は色をつける前のステップの状態の表示
+func (r *ConsoleReporter) statusText(status validator.ValidationStatus) string {
+	switch status {
+	case validator.StatusPassed:
+		return r.sym.passed + " " + i18n.T("status.passed")
+	case validator.StatusFailed:
+		return r.sym.failed + " " + i18n.T("status.failed")
+	case validator.StatusWarning:
+		return r.sym.warning + " " + i18n.T("status.warning")
 	case validator.StatusSkipped:
-		return "⏭️  SKIPPED"
+		return r.sym.skipped + " " + i18n.T("status.skipped")
 	default:
-		return "⏸️  PENDING"
+		return r.sym.pending + " " + i18n.T("status.pending")
 	}
 }
 
 func (r *ConsoleReporter) getResourceStatusIcon(status validator.ResourceStatus) string {
+	symbol := r.resourceSymbol(status)
 	switch status {
 	case validator.ResourceExists:
-		return "✅"
+		return r.paint(ansiGreen, symbol)
 	case validator.ResourceNotFound:
-		return "❌"
+		return r.paint(ansiRed, symbol)
 	case validator.ResourceMisconfigured:
-		return "⚠️ "
+		return r.paint(ansiYellow, symbol)
 	default:
-		return "⏸️ "
+		return r.paint(ansiGray, symbol)
+	}
+}
+
+func (r *ConsoleReporter) resourceSymbol(status validator.ResourceStatus) string {
+	switch status {
+	case validator.ResourceExists:
+		return r.sym.passed
+	case validator.ResourceNotFound:
+		return r.sym.failed
+	case validator.ResourceMisconfigured:
+		return r.sym.warning
+	default:
+		return r.sym.pending
+	}
+}
+
+func (r *ConsoleReporter) flowIcon(flow validator.FlowResult) string {
+	if flow.Reachable {
+		return r.paint(ansiGreen, r.sym.passed)
+	}
+	if flow.Indeterminate {
+		return r.paint(ansiYellow, r.sym.warning)
+	}
+	return r.paint(ansiRed, r.sym.failed)
+}
+
+// paint は色が有効な場合だけtextにANSIカラーをつける
+func (r *ConsoleReporter) paint(code, text string) string {
+	if !r.options.Color {
+		return text
+	}
+	return code + text + ansiReset
+}
+
+// separator は区切り線を返す。端末の幅が狭い場合は幅に合わせる
+func (r *ConsoleReporter) separator(char string, length int) string {
+	if r.options.Width > 0 && r.options.Width < length {
+		length = r.options.Width
+	}
+	return strings.Repeat(char, length)
+}
+
+// printWrapped はprefixに続けてtextを端末の幅で折り返して表示する。2行目以降はprefixの幅だけ字下げする
+func (r *ConsoleReporter) printWrapped(prefix, text string) {
+	prefixWidth := displayWidth(stripANSI(prefix))
+	width := 0
+	// 残りの幅が狭すぎる場合は折り返さない
+	if r.options.Width-prefixWidth >= 20 {
+		width = r.options.Width - prefixWidth
+	}
+
+	for i, line := range wrapText(text, width) {
+		if i == 0 {
+			fmt.Fprintln(r.out, prefix+line)
+			continue
+		}
+		fmt.Fprintln(r.out, strings.Repeat(" ", prefixWidth)+line)
 	}
 }
 
 func (r *ConsoleReporter) Error(err error) {
-	fmt.Fprintf(os.Stderr, "❌ Error: %v\n", err)
+	fmt.Fprintln(os.Stderr, r.sym.failed, i18n.T("console.error", err))
+}
+
+// stripANSI はANSIカラーの制御文字を取り除く
+func stripANSI(s string) string {
+	for _, code := range []string{ansiReset, ansiBold, ansiRed, ansiGreen, ansiYellow, ansiGray} {
+		s = strings.ReplaceAll(s, code, "")
+	}
+	return s
+}
+
+// ruleExpectation は検証ルールの種類に応じて期待する条件を表示用の文字列にする
+func ruleExpectation(rule validator.RuleResult) string {
+	switch rule.Type {
+	case "exists":
+		return "exists"
+	case "count":
+		return fmt.Sprintf("count %s %s", rule.Operator, formatValue(rule.Expected))
+	default:
+		if rule.Operator == "" {
+			return formatValue(rule.Expected)
+		}
+		return fmt.Sprintf("%s %s", rule.Operator, formatValue(rule.Expected))
+	}
+}
+
+func prettyJSON(value interface{}) string {
+	data, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(data)
+}
+
+func indentLines(text string, spaces int) string {
+	pad := strings.Repeat(" ", spaces)
+	return pad + strings.ReplaceAll(text, "\n", "\n"+pad)
 }
@@ -0,0 +1,164 @@
+package reporter
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"sbcntr2-test-tool/internal/i18n"
+	"sbcntr2-test-tool/internal/validator"
+	"time"
+)
+
+// HTMLReporter はCSSを埋め込んだ単一ファイルのHTMLレポートを出力する
+// ステップやリソースは<details>で折りたたみ、失敗したものだけを開いた状態で表示する
+type HTMLReporter struct {
+	out io.Writer
+}
+
+func NewHTMLReporter(out io.Writer) *HTMLReporter {
+	return &HTMLReporter{out: out}
+}
+
+type htmlReport struct {
+	GeneratedAt time.Time
+	Summary     *validator.ValidationSummary
+	Results     []validator.ValidationResult
+}
+
+func (r *HTMLReporter) ReportResult(result *validator.ValidationResult) error {
+	return r.render(htmlReport{
+		GeneratedAt: time.Now(),
+		Results:     []validator.ValidationResult{*result},
+	})
+}
+
+func (r *HTMLReporter) ReportSummary(summary *validator.ValidationSummary) error {
+	return r.render(htmlReport{
+		GeneratedAt: time.Now(),
+		Summary:     summary,
+		Results:     summary.Results,
+	})
+}
+
+func (r *HTMLReporter) render(report htmlReport) error {
+	tmpl, err := template.New("report").Funcs(template.FuncMap{
+		"statusLabel":         statusLabel,
+		"resourceStatusLabel": resourceStatusLabel,
+		"ruleLabel":           ruleLabel,
+		"formatValue":         formatValue,
+		"isURL":               isURL,
+		"statusClass": func(status validator.ValidationStatus) string {
+			return status.String()
+		},
+		"resourceOK": func(resource validator.ResourceResult) bool {
+			return resource.Status == validator.ResourceExists && len(resource.Warnings) == 0
+		},
+		"stepOK": func(result validator.ValidationResult) bool {
+			return result.Status == validator.StatusPassed
+		},
+		"t":    i18n.T,
+		"lang": i18n.Current,
+		"duration": func(d time.Duration) string {
+			return d.Round(time.Millisecond).String()
+		},
+	}).Parse(htmlTemplate)
+	if err != nil {
+		return fmt.Errorf("failed to parse HTML template: %w", err)
+	}
+
+	if err := tmpl.Execute(r.out, report); err != nil {
+		return fmt.Errorf("failed to render HTML report: %w", err)
+	}
+
+	return nil
+}
+
+const htmlTemplate = `<!DOCTYPE html>
+<html lang="{{lang}}">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{t "report.title"}}</title>
+<style>
+body { font-family: -apple-system, "Segoe UI", "Hiragino Sans", Meiryo, sans-serif; margin: 2em; color: #24292f; }
+h1 { font-size: 1.6em; }
+table { border-collapse: collapse; width: 100%; margin: 0.5em 0 1em; font-size: 0.9em; }
+th, td { border: 1px solid #d0d7de; padding: 4px 8px; text-align: left; vertical-align: top; }
+th { background: #f6f8fa; }
+td.value { font-family: ui-monospace, Menlo, Consolas, monospace; white-space: pre-wrap; word-break: break-all; }
+details { border: 1px solid #d0d7de; border-radius: 6px; margin: 0.5em 0; padding: 0.5em 1em; }
+details details { margin-left: 1em; }
+summary { cursor: pointer; font-weight: 600; }
+.PASSED { color: #1a7f37; } .FAILED { color: #cf222e; } .WARNING { color: #9a6700; } .SKIPPED, .PENDING { color: #57606a; }
+tr.fail { background: #ffebe9; } tr.warn { background: #fff8c5; }
+.meta { color: #57606a; font-size: 0.9em; }
+ul.errors li { margin-bottom: 0.5em; }
+</style>
+</head>
+<body>
+<h1>{{t "report.title"}}</h1>
+<p class="meta">{{t "report.generated_at" (.GeneratedAt.Format "2006-01-02 15:04:05 MST")}}</p>
+{{with .Summary}}
+<h2>{{t "report.summary"}}</h2>
+<table>
+<tr><th>{{t "report.total"}}</th><th>{{t "report.passed"}}</th><th>{{t "report.failed"}}</th><th>{{t "report.skipped"}}</th></tr>
+<tr><td>{{.TotalSteps}}</td><td class="PASSED">{{.PassedSteps}}</td><td class="FAILED">{{.FailedSteps}}</td><td class="SKIPPED">{{.SkippedSteps}}</td></tr>
+</table>
+<table>
+<tr><th>{{t "report.step"}}</th><th>{{t "report.name"}}</th><th>{{t "report.status"}}</th><th>{{t "report.duration"}}</th></tr>
+{{range .Results}}<tr><td>{{.StepNumber}}</td><td><a href="#step-{{.StepNumber}}">{{.StepName}}</a></td><td class="{{statusClass .Status}}">{{statusLabel .Status}}</td><td>{{duration .Duration}}</td></tr>
+{{end}}</table>
+{{end}}
+{{range .Results}}
+<details id="step-{{.StepNumber}}"{{if not (stepOK .)}} open{{end}}>
+<summary><span class="{{statusClass .Status}}">{{statusLabel .Status}}</span> {{t "report.step_title" .StepNumber .StepName}} <span class="meta">({{duration .Duration}})</span></summary>
+{{if .Errors}}
+<h3>{{t "report.errors"}}</h3>
+<ul class="errors">
+{{range .Errors}}<li>❌ {{.Message}}
+{{if .Suggestion}}<br>💡 {{.Suggestion}}{{end}}
+{{if .DocumentRef}}<br>📖 {{if isURL .DocumentRef}}<a href="{{.DocumentRef}}">{{.DocumentRef}}</a>{{else}}{{.DocumentRef}}{{end}}{{end}}</li>
+{{end}}</ul>
+{{end}}
+{{if .Warnings}}
+<h3>{{t "report.warnings"}}</h3>
+<ul>
+{{range .Warnings}}<li>⚠️ {{.Resource}}: {{.Message}}</li>
+{{end}}</ul>
+{{end}}
+{{if .Resources}}
+<h3>{{t "report.resources"}}</h3>
+{{range .Resources}}
+<details{{if not (resourceOK .)}} open{{end}}>
+<summary>{{resourceStatusLabel .Status}} {{.Name}} <span class="meta">{{.Type}}{{if .StackName}} · {{.StackName}}/{{.LogicalID}}{{end}} · {{duration .Duration}}</span></summary>
+{{if .Errors}}<ul>{{range .Errors}}<li>❌ {{.}}</li>{{end}}</ul>{{end}}
+{{if .Rules}}
+<table>
+<tr><th>{{t "report.result"}}</th><th>{{t "report.rule"}}</th><th>{{t "report.property"}}</th><th>{{t "report.expected"}}</th><th>{{t "report.actual"}}</th><th>{{t "report.message"}}</th></tr>
+{{range .Rules}}<tr{{if not .Passed}}{{if eq .Severity "error"}} class="fail"{{else}} class="warn"{{end}}{{end}}>
+<td>{{ruleLabel .}}</td><td>{{.Name}}</td><td class="value">{{.Property}}</td><td class="value">{{formatValue .Expected}}</td><td class="value">{{formatValue .Actual}}</td>
+<td>{{.Message}}{{if and (not .Passed) .Suggestion}}<br>💡 {{.Suggestion}}{{end}}{{if and (not .Passed) .DocumentRef}}<br>📖 {{if isURL .DocumentRef}}<a href="{{.DocumentRef}}">{{.DocumentRef}}</a>{{else}}{{.DocumentRef}}{{end}}{{end}}</td></tr>
+{{end}}</table>
+{{end}}
+</details>
+{{end}}
+{{end}}
+{{if .Flows}}
+<h3>{{t "report.reachability"}}</h3>
+<table>
+<tr><th>{{t "report.result"}}</th><th>{{t "report.from"}}</th><th>{{t "report.to"}}</th><th>{{t "report.port"}}</th><th>{{t "report.blocked_at"}}</th><th>{{t "report.reason"}}</th></tr>
+{{range .Flows}}<tr{{if .Indeterminate}} class="warn"{{else if not .Reachable}} class="fail"{{end}}><td>{{if .Reachable}}✅{{else if .Indeterminate}}⚠️{{else}}❌{{end}}</td><td>{{.From}}</td><td>{{.To}}</td><td>{{.Protocol}}/{{.Port}}</td><td>{{.BlockingHop}}</td><td>{{.Reason}}</td></tr>
+{{end}}</table>
+{{end}}
+{{if .Drifts}}
+<h3>{{t "report.drift"}}</h3>
+<table>
+<tr><th>{{t "report.stack"}}</th><th>{{t "report.logical_id"}}</th><th>{{t "report.type"}}</th><th>{{t "report.drift_status"}}</th></tr>
+{{range $drift := .Drifts}}{{range .Resources}}{{if ne .DriftStatus "IN_SYNC"}}<tr><td>{{$drift.StackName}}</td><td>{{.LogicalID}}</td><td>{{.Type}}</td><td>{{.DriftStatus}}</td></tr>
+{{end}}{{end}}{{end}}</table>
+{{end}}
+</details>
+{{end}}
+</body>
+</html>
+`
@@ -1,8 +1,58 @@
 package reporter
 
-import "sbcntr2-test-tool/internal/validator"
+import (
+	"errors"
+	"sbcntr2-test-tool/internal/validator"
+)
 
 type Reporter interface {
 	ReportResult(result *validator.ValidationResult) error
 	ReportSummary(summary *validator.ValidationSummary) error
 }
+
+// MultiReporter は1回の検証結果を複数のレポーターに出力する
+// 途中のレポーターが失敗しても残りのレポーターには出力する
+type MultiReporter struct {
+	reporters []Reporter
+}
+
+func NewMultiReporter(reporters ...Reporter) *MultiReporter {
+	return &MultiReporter{reporters: reporters}
+}
+
+func (r *MultiReporter) ReportResult(result *validator.ValidationResult) error {
+	var errs []error
+	for _, rep := range r.reporters {
+		if err := rep.ReportResult(result); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (r *MultiReporter) ReportSummary(summary *validator.ValidationSummary) error {
+	var errs []error
+	for _, rep := range r.reporters {
+		if err := rep.ReportSummary(summary); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Observers はレポーター（MultiReporterの場合はその中のレポーター）のうち、
+// 検証の進行を受け取るものを返す
+func Observers(rep Reporter) []validator.Observer {
+	if multi, ok := rep.(*MultiReporter); ok {
+		var observers []validator.Observer
+		for _, r := range multi.reporters {
+			observers = append(observers, Observers(r)...)
+		}
+		return observers
+	}
+
+	if observer, ok := rep.(validator.Observer); ok {
+		return []validator.Observer{observer}
+	}
+	return nil
+}
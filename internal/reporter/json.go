@@ -3,33 +3,151 @@ package reporter
 import (
 	"encoding/json"
 	"fmt"
-	"os"
+	"io"
 	"sbcntr2-test-tool/internal/validator"
 )
 
-type JSONReporter struct{}
+// JSONSchemaVersion は --output json の出力形式のバージョン
+// フィールドを追加した場合はマイナーバージョン、削除や型の変更をした場合はメジャーバージョンを上げ、
+// schema/validation-result.schema.json も合わせて更新する
+const JSONSchemaVersion = "1.0.0"
 
-func NewJSONReporter() *JSONReporter {
-	return &JSONReporter{}
+type JSONReporter struct {
+	out io.Writer
 }
 
-func (r *JSONReporter) ReportResult(result *validator.ValidationResult) error {
-	output := r.formatResult(result)
+func NewJSONReporter(out io.Writer) *JSONReporter {
+	return &JSONReporter{out: out}
+}
 
-	encoder := json.NewEncoder(os.Stdout)
-	encoder.SetIndent("", "  ")
+// jsonStepReport は --step の出力
+type jsonStepReport struct {
+	SchemaVersion string `json:"schemaVersion"`
+	jsonStep
+}
 
-	if err := encoder.Encode(output); err != nil {
-		return fmt.Errorf("failed to encode JSON: %w", err)
-	}
+// jsonSummaryReport は --all の出力
+type jsonSummaryReport struct {
+	SchemaVersion string     `json:"schemaVersion"`
+	TotalSteps    int        `json:"totalSteps"`
+	PassedSteps   int        `json:"passedSteps"`
+	FailedSteps   int        `json:"failedSteps"`
+	SkippedSteps  int        `json:"skippedSteps"`
+	Results       []jsonStep `json:"results"`
+}
 
-	return nil
+type jsonStep struct {
+	StepNumber int            `json:"stepNumber"`
+	StepName   string         `json:"stepName"`
+	Status     string         `json:"status"`
+	DurationMs int64          `json:"durationMs"`
+	Resources  []jsonResource `json:"resources"`
+	Errors     []jsonError    `json:"errors"`
+	Warnings   []jsonWarning  `json:"warnings"`
+	Drifts     []jsonDrift    `json:"drifts"`
+	Flows      []jsonFlow     `json:"flows"`
+}
+
+type jsonResource struct {
+	Type       string                 `json:"type"`
+	ID         string                 `json:"id"`
+	Name       string                 `json:"name"`
+	Required   bool                   `json:"required"`
+	Status     string                 `json:"status"`
+	StackName  string                 `json:"stackName"`
+	LogicalID  string                 `json:"logicalId"`
+	DurationMs int64                  `json:"durationMs"`
+	Expected   map[string]interface{} `json:"expected"`
+	Actual     map[string]interface{} `json:"actual"`
+	Rules      []jsonRule             `json:"rules"`
+	Errors     []string               `json:"errors"`
+	Warnings   []string               `json:"warnings"`
+}
+
+// jsonRule はリソースに適用した検証ルールごとの結果
+type jsonRule struct {
+	Name        string      `json:"name"`
+	Type        string      `json:"type"`
+	Property    string      `json:"property"`
+	Operator    string      `json:"operator"`
+	Expected    interface{} `json:"expected"`
+	Actual      interface{} `json:"actual"`
+	Passed      bool        `json:"passed"`
+	Severity    string      `json:"severity"`
+	Message     string      `json:"message"`
+	Suggestion  string      `json:"suggestion"`
+	DocumentRef string      `json:"documentRef"`
+}
+
+type jsonError struct {
+	Type        string      `json:"type"`
+	Resource    string      `json:"resource"`
+	Property    string      `json:"property"`
+	Expected    interface{} `json:"expected"`
+	Actual      interface{} `json:"actual"`
+	Message     string      `json:"message"`
+	Suggestion  string      `json:"suggestion"`
+	DocumentRef string      `json:"documentRef"`
+}
+
+type jsonWarning struct {
+	Resource string `json:"resource"`
+	Message  string `json:"message"`
+}
+
+type jsonDrift struct {
+	StackName       string              `json:"stackName"`
+	DetectionStatus string              `json:"detectionStatus"`
+	DriftStatus     string              `json:"driftStatus"`
+	Resources       []jsonDriftResource `json:"resources"`
+}
+
+type jsonDriftResource struct {
+	LogicalID           string                   `json:"logicalId"`
+	PhysicalID          string                   `json:"physicalId"`
+	Type                string                   `json:"type"`
+	DriftStatus         string                   `json:"driftStatus"`
+	PropertyDifferences []jsonPropertyDifference `json:"propertyDifferences"`
+}
+
+type jsonPropertyDifference struct {
+	PropertyPath   string `json:"propertyPath"`
+	ExpectedValue  string `json:"expectedValue"`
+	ActualValue    string `json:"actualValue"`
+	DifferenceType string `json:"differenceType"`
+}
+
+type jsonFlow struct {
+	From          string        `json:"from"`
+	To            string        `json:"to"`
+	Protocol      string        `json:"protocol"`
+	Port          int           `json:"port"`
+	Reachable     bool          `json:"reachable"`
+	Indeterminate bool          `json:"indeterminate"`
+	BlockingHop   string        `json:"blockingHop"`
+	Reason        string        `json:"reason"`
+	Hops          []jsonFlowHop `json:"hops"`
+}
+
+type jsonFlowHop struct {
+	Name    string `json:"name"`
+	Allowed bool   `json:"allowed"`
+	Detail  string `json:"detail"`
+}
+
+func (r *JSONReporter) ReportResult(result *validator.ValidationResult) error {
+	return r.encode(jsonStepReport{
+		SchemaVersion: JSONSchemaVersion,
+		jsonStep:      r.formatResult(result),
+	})
 }
 
 func (r *JSONReporter) ReportSummary(summary *validator.ValidationSummary) error {
-	output := r.formatSummary(summary)
+	return r.encode(r.formatSummary(summary))
+}
 
-	encoder := json.NewEncoder(os.Stdout)
+func (r *JSONReporter) encode(output interface{}) error {
+	encoder := json.NewEncoder(r.out)
 	encoder.SetIndent("", "  ")
 
 	if err := encoder.Encode(output); err != nil {
@@ -39,65 +157,158 @@ func (r *JSONReporter) ReportSummary(summary *validator.ValidationSummary) error
 	return nil
 }
 
-func (r *JSONReporter) formatResult(result *validator.ValidationResult) map[string]interface{} {
-	resources := make([]map[string]interface{}, 0, len(result.Resources))
+// formatResult はステップの検証結果を出力用の構造体にする
+// スキーマで配列・オブジェクトと定義したフィールドは、空の場合もnullではなく [] や {} にする
+func (r *JSONReporter) formatResult(result *validator.ValidationResult) jsonStep {
+	resources := make([]jsonResource, 0, len(result.Resources))
 	for _, res := range result.Resources {
-		resources = append(resources, map[string]interface{}{
-			"type":     res.Type,
-			"id":       res.ID,
-			"name":     res.Name,
-			"status":   res.Status.String(),
-			"expected": res.Expected,
-			"actual":   res.Actual,
-			"errors":   res.Errors,
-			"warnings": res.Warnings,
+		rules := make([]jsonRule, 0, len(res.Rules))
+		for _, rule := range res.Rules {
+			rules = append(rules, jsonRule{
+				Name:        rule.Name,
+				Type:        rule.Type,
+				Property:    rule.Property,
+				Operator:    rule.Operator,
+				Expected:    rule.Expected,
+				Actual:      rule.Actual,
+				Passed:      rule.Passed,
+				Severity:    rule.Severity,
+				Message:     rule.Message,
+				Suggestion:  rule.Suggestion,
+				DocumentRef: rule.DocumentRef,
+			})
+		}
+
+		resources = append(resources, jsonResource{
+			Type:       res.Type,
+			ID:         res.ID,
+			Name:       res.Name,
+			Required:   res.Required,
+			Status:     res.Status.String(),
+			StackName:  res.StackName,
+			LogicalID:  res.LogicalID,
+			DurationMs: res.Duration.Milliseconds(),
+			Expected:   nonNilMap(res.Expected),
+			Actual:     nonNilMap(res.Actual),
+			Rules:      rules,
+			Errors:     nonNilStrings(res.Errors),
+			Warnings:   nonNilStrings(res.Warnings),
 		})
 	}
 
-	errors := make([]map[string]interface{}, 0, len(result.Errors))
+	errors := make([]jsonError, 0, len(result.Errors))
 	for _, err := range result.Errors {
-		errors = append(errors, map[string]interface{}{
-			"type":        err.Type,
-			"resource":    err.Resource,
-			"property":    err.Property,
-			"expected":    err.Expected,
-			"actual":      err.Actual,
-			"message":     err.Message,
-			"suggestion":  err.Suggestion,
-			"documentRef": err.DocumentRef,
+		errors = append(errors, jsonError{
+			Type:        err.Type.String(),
+			Resource:    err.Resource,
+			Property:    err.Property,
+			Expected:    err.Expected,
+			Actual:      err.Actual,
+			Message:     err.Message,
+			Suggestion:  err.Suggestion,
+			DocumentRef: err.DocumentRef,
 		})
 	}
 
-	warnings := make([]map[string]interface{}, 0, len(result.Warnings))
+	warnings := make([]jsonWarning, 0, len(result.Warnings))
 	for _, warn := range result.Warnings {
-		warnings = append(warnings, map[string]interface{}{
-			"resource": warn.Resource,
-			"message":  warn.Message,
+		warnings = append(warnings, jsonWarning{
+			Resource: warn.Resource,
+			Message:  warn.Message,
+		})
+	}
+
+	drifts := make([]jsonDrift, 0, len(result.Drifts))
+	for _, drift := range result.Drifts {
+		driftResources := make([]jsonDriftResource, 0, len(drift.Resources))
+		for _, res := range drift.Resources {
+			differences := make([]jsonPropertyDifference, 0, len(res.PropertyDifferences))
+			for _, diff := range res.PropertyDifferences {
+				differences = append(differences, jsonPropertyDifference{
+					PropertyPath:   diff.PropertyPath,
+					ExpectedValue:  diff.ExpectedValue,
+					ActualValue:    diff.ActualValue,
+					DifferenceType: diff.DifferenceType,
+				})
+			}
+			driftResources = append(driftResources, jsonDriftResource{
+				LogicalID:           res.LogicalID,
+				PhysicalID:          res.PhysicalID,
+				Type:                res.Type,
+				DriftStatus:         res.DriftStatus,
+				PropertyDifferences: differences,
+			})
+		}
+		drifts = append(drifts, jsonDrift{
+			StackName:       drift.StackName,
+			DetectionStatus: drift.DetectionStatus,
+			DriftStatus:     drift.DriftStatus,
+			Resources:       driftResources,
+		})
+	}
+
+	flows := make([]jsonFlow, 0, len(result.Flows))
+	for _, flow := range result.Flows {
+		hops := make([]jsonFlowHop, 0, len(flow.Hops))
+		for _, hop := range flow.Hops {
+			hops = append(hops, jsonFlowHop{
+				Name:    hop.Name,
+				Allowed: hop.Allowed,
+				Detail:  hop.Detail,
+			})
+		}
+		flows = append(flows, jsonFlow{
+			From:          flow.From,
+			To:            flow.To,
+			Protocol:      flow.Protocol,
+			Port:          flow.Port,
+			Reachable:     flow.Reachable,
+			Indeterminate: flow.Indeterminate,
+			BlockingHop:   flow.BlockingHop,
+			Reason:        flow.Reason,
+			Hops:          hops,
 		})
 	}
 
-	return map[string]interface{}{
-		"stepNumber": result.StepNumber,
-		"stepName":   result.StepName,
-		"status":     result.Status.String(),
-		"duration":   result.Duration.String(),
-		"resources":  resources,
-		"errors":     errors,
-		"warnings":   warnings,
+	return jsonStep{
+		StepNumber: result.StepNumber,
+		StepName:   result.StepName,
+		Status:     result.Status.String(),
+		DurationMs: result.Duration.Milliseconds(),
+		Resources:  resources,
+		Errors:     errors,
+		Warnings:   warnings,
+		Drifts:     drifts,
+		Flows:      flows,
 	}
 }
 
-func (r *JSONReporter) formatSummary(summary *validator.ValidationSummary) map[string]interface{} {
-	results := make([]map[string]interface{}, 0, len(summary.Results))
+func (r *JSONReporter) formatSummary(summary *validator.ValidationSummary) jsonSummaryReport {
+	results := make([]jsonStep, 0, len(summary.Results))
 	for _, res := range summary.Results {
 		results = append(results, r.formatResult(&res))
 	}
 
-	return map[string]interface{}{
-		"totalSteps":   summary.TotalSteps,
-		"passedSteps":  summary.PassedSteps,
-		"failedSteps":  summary.FailedSteps,
-		"skippedSteps": summary.SkippedSteps,
-		"results":      results,
+	return jsonSummaryReport{
+		SchemaVersion: JSONSchemaVersion,
+		TotalSteps:    summary.TotalSteps,
+		PassedSteps:   summary.PassedSteps,
+		FailedSteps:   summary.FailedSteps,
+		SkippedSteps:  summary.SkippedSteps,
+		Results:       results,
+	}
+}
+
+func nonNilMap(m map[string]interface{}) map[string]interface{} {
+	if m == nil {
+		return map[string]interface{}{}
+	}
+	return m
+}
+
+func nonNilStrings(s []string) []string {
+	if s == nil {
+		return []string{}
 	}
+	return s
 }
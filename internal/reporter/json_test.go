@@ -0,0 +1,456 @@
+package reporter
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"sbcntr2-test-tool/internal/validator"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+const jsonSchemaFile = "../../schema/validation-result.schema.json"
+
+// --output json の出力が schema/validation-result.schema.json に従っていることを確認する
+// 出力にスキーマで定義していないフィールドがある場合も失敗にして、スキーマの更新漏れを防ぐ
+
+func TestJSONOutputStepReportMatchesSchema(t *testing.T) {
+	schema := loadJSONSchema(t)
+
+	var buf bytes.Buffer
+	if err := NewJSONReporter(&buf).ReportResult(sampleValidationResult()); err != nil {
+		t.Fatalf("ReportResult: %v", err)
+	}
+
+	output := decodeJSONOutput(t, buf.Bytes())
+	schema.assertValid(t, output)
+
+	if got := output["schemaVersion"]; got != JSONSchemaVersion {
+		t.Errorf("schemaVersion = %v, want %s", got, JSONSchemaVersion)
+	}
+	errors := output["errors"].([]interface{})
+	if got := errors[1].(map[string]interface{})["type"]; got != "NETWORK_FAILURE" {
+		t.Errorf("errors[1].type = %v, want NETWORK_FAILURE", got)
+	}
+	if got := output["durationMs"]; got != float64(2340) {
+		t.Errorf("durationMs = %v, want 2340", got)
+	}
+
+	resource := output["resources"].([]interface{})[0].(map[string]interface{})
+	if resource["stackName"] != "sbcntr-base" || resource["logicalId"] != "SbcntrVpc" {
+		t.Errorf("stackName/logicalId = %v/%v, want sbcntr-base/SbcntrVpc", resource["stackName"], resource["logicalId"])
+	}
+	if got := resource["durationMs"]; got != float64(1250) {
+		t.Errorf("resources[0].durationMs = %v, want 1250", got)
+	}
+	rules := resource["rules"].([]interface{})
+	if len(rules) != 3 {
+		t.Fatalf("resources[0].rules has %d rules, want 3", len(rules))
+	}
+	rule := rules[0].(map[string]interface{})
+	if rule["name"] != "vpc_cidr_check" || rule["passed"] != false || rule["actual"] != "10.1.0.0/16" {
+		t.Errorf("resources[0].rules[0] = %v", rule)
+	}
+}
+
+func TestJSONOutputSummaryReportMatchesSchema(t *testing.T) {
+	schema := loadJSONSchema(t)
+
+	result := sampleValidationResult()
+	summary := &validator.ValidationSummary{
+		TotalSteps:   7,
+		FailedSteps:  1,
+		SkippedSteps: 6,
+		Results:      []validator.ValidationResult{*result},
+	}
+
+	var buf bytes.Buffer
+	if err := NewJSONReporter(&buf).ReportSummary(summary); err != nil {
+		t.Fatalf("ReportSummary: %v", err)
+	}
+
+	output := decodeJSONOutput(t, buf.Bytes())
+	schema.assertValid(t, output)
+
+	if got := output["schemaVersion"]; got != JSONSchemaVersion {
+		t.Errorf("schemaVersion = %v, want %s", got, JSONSchemaVersion)
+	}
+	if _, ok := output["results"].([]interface{})[0].(map[string]interface{})["schemaVersion"]; ok {
+		t.Errorf("results[0] should not have schemaVersion")
+	}
+}
+
+func TestJSONOutputEmptyResultMatchesSchema(t *testing.T) {
+	schema := loadJSONSchema(t)
+
+	var buf bytes.Buffer
+	if err := NewJSONReporter(&buf).ReportResult(&validator.ValidationResult{StepNumber: 1}); err != nil {
+		t.Fatalf("ReportResult: %v", err)
+	}
+
+	schema.assertValid(t, decodeJSONOutput(t, buf.Bytes()))
+}
+
+// 列挙型の値がすべてスキーマのenumに含まれていることを確認する
+func TestJSONSchemaEnums(t *testing.T) {
+	schema := loadJSONSchema(t)
+
+	tests := []struct {
+		pointer string
+		values  []fmt.Stringer
+	}{
+		{"#/$defs/step/properties/status", []fmt.Stringer{
+			validator.StatusPending, validator.StatusPassed, validator.StatusFailed, validator.StatusWarning, validator.StatusSkipped,
+		}},
+		{"#/$defs/resource/properties/status", []fmt.Stringer{
+			validator.ResourceNotFound, validator.ResourceExists, validator.ResourceMisconfigured, validator.ResourcePending,
+		}},
+		{"#/$defs/error/properties/type", []fmt.Stringer{
+			validator.ErrorResourceNotFound, validator.ErrorPropertyMismatch, validator.ErrorConfigurationInvalid,
+			validator.ErrorAWSAPIFailure, validator.ErrorAuthenticationFailure, validator.ErrorPermissionDenied, validator.ErrorNetworkFailure,
+		}},
+	}
+
+	for _, tt := range tests {
+		enum, _ := schema.resolve(tt.pointer)["enum"].([]interface{})
+		if len(enum) != len(tt.values) {
+			t.Errorf("%s: enum has %d values, want %d", tt.pointer, len(enum), len(tt.values))
+		}
+		for _, value := range tt.values {
+			if !containsValue(enum, value.String()) {
+				t.Errorf("%s: enum does not contain %s", tt.pointer, value)
+			}
+		}
+	}
+}
+
+func sampleValidationResult() *validator.ValidationResult {
+	return &validator.ValidationResult{
+		StepNumber: 1,
+		StepName:   "Network Construction",
+		Status:     validator.StatusFailed,
+		Duration:   2340 * time.Millisecond,
+		Resources: []validator.ResourceResult{
+			{
+				Type:      "AWS::EC2::VPC",
+				ID:        "vpc-12345",
+				Name:      "sbcntr-vpc",
+				Required:  true,
+				Status:    validator.ResourceMisconfigured,
+				StackName: "sbcntr-base",
+				LogicalID: "SbcntrVpc",
+				Duration:  1250 * time.Millisecond,
+				Expected:  map[string]interface{}{"CidrBlock": "10.0.0.0/16"},
+				Actual:    map[string]interface{}{"CidrBlock": "10.1.0.0/16", "Tags": []interface{}{}},
+				Rules: []validator.RuleResult{
+					{
+						Name:         "vpc_cidr_check",
+						Type:         "property",
+						Property:     "CidrBlock",
+						Operator:     "eq",
+						Expected:     "10.0.0.0/16",
+						Actual:       "10.1.0.0/16",
+						Severity:     "error",
+						Message:      "VPC CIDR block should be 10.0.0.0/16",
+						ErrorMessage: "VPC CIDR block should be 10.0.0.0/16",
+					},
+					{
+						Name:     "vpc_dns_support",
+						Type:     "property",
+						Property: "EnableDnsSupport",
+						Operator: "eq",
+						Expected: true,
+						Actual:   true,
+						Severity: "error",
+						Passed:   true,
+					},
+					{
+						// 取得できなかったプロパティはActualがnilになる
+						Name:     "vpc_tags_exist",
+						Type:     "exists",
+						Property: "Tags[0].Key",
+						Severity: "warning",
+						Passed:   true,
+					},
+				},
+				Errors:   []string{"VPC CIDR block should be 10.0.0.0/16"},
+				Warnings: []string{},
+			},
+			{
+				// 存在しないリソースはExpected/Actual等がnilの場合がある
+				Type:   "AWS::EC2::Subnet",
+				Name:   "sbcntr-subnet-public-ingress-1a",
+				Status: validator.ResourceNotFound,
+			},
+		},
+		Errors: []validator.ValidationError{
+			{
+				Type:        validator.ErrorResourceNotFound,
+				Resource:    "sbcntr-subnet-public-ingress-1a",
+				Message:     "Required resource 'sbcntr-subnet-public-ingress-1a' not found",
+				Suggestion:  "Please create the resource",
+				DocumentRef: "Step 1",
+			},
+			{
+				Type:     validator.ErrorNetworkFailure,
+				Resource: "sbcntr-sg-ingress -> sbcntr-sg-container (tcp/80)",
+				Property: "security-group-ingress sbcntr-sg-container",
+				Expected: 80,
+				Actual:   map[string]interface{}{"port": 8080},
+				Message:  "Traffic is blocked",
+			},
+		},
+		Warnings: []validator.ValidationWarning{
+			{Resource: "sbcntr-base", Message: "Failed to detect drift"},
+		},
+		Drifts: []validator.StackDrift{
+			{
+				StackName:       "sbcntr-base",
+				DetectionStatus: "DETECTION_COMPLETE",
+				DriftStatus:     "DRIFTED",
+				Resources: []validator.ResourceDrift{
+					{
+						LogicalID:   "SbcntrVpc",
+						PhysicalID:  "vpc-12345",
+						Type:        "AWS::EC2::VPC",
+						DriftStatus: "MODIFIED",
+						PropertyDifferences: []validator.PropertyDifference{
+							{PropertyPath: "/CidrBlock", ExpectedValue: "10.0.0.0/16", ActualValue: "10.1.0.0/16", DifferenceType: "NOT_EQUAL"},
+						},
+					},
+				},
+			},
+		},
+		Flows: []validator.FlowResult{
+			{
+				From:        "sbcntr-sg-ingress",
+				To:          "sbcntr-sg-container",
+				Protocol:    "tcp",
+				Port:        80,
+				BlockingHop: "security-group-ingress sbcntr-sg-container",
+				Reason:      "no ingress rule allows tcp/80",
+				Hops: []validator.FlowHop{
+					{Name: "security-group-egress sbcntr-sg-ingress", Allowed: true, Detail: "allowed"},
+					{Name: "security-group-ingress sbcntr-sg-container", Allowed: false, Detail: "no ingress rule allows tcp/80"},
+				},
+			},
+		},
+	}
+}
+
+func decodeJSONOutput(t *testing.T, data []byte) map[string]interface{} {
+	t.Helper()
+
+	var output map[string]interface{}
+	if err := json.Unmarshal(data, &output); err != nil {
+		t.Fatalf("output is not valid JSON: %v\n%s", err, data)
+	}
+	return output
+}
+
+// jsonSchema はテストに必要な範囲（$ref, allOf, oneOf, type, const, enum, required, properties, items, minimum）だけを評価する
+type jsonSchema struct {
+	root map[string]interface{}
+}
+
+func loadJSONSchema(t *testing.T) *jsonSchema {
+	t.Helper()
+
+	data, err := os.ReadFile(jsonSchemaFile)
+	if err != nil {
+		t.Fatalf("failed to read JSON schema: %v", err)
+	}
+
+	var root map[string]interface{}
+	if err := json.Unmarshal(data, &root); err != nil {
+		t.Fatalf("JSON schema is not valid JSON: %v", err)
+	}
+	return &jsonSchema{root: root}
+}
+
+func (s *jsonSchema) assertValid(t *testing.T, value interface{}) {
+	t.Helper()
+
+	for _, err := range s.validate(s.root, value, "$", true) {
+		t.Error(err)
+	}
+}
+
+// resolve は "#/$defs/step" のようなスキーマ内の参照を解決する
+func (s *jsonSchema) resolve(ref string) map[string]interface{} {
+	var node interface{} = s.root
+	for _, key := range strings.Split(strings.TrimPrefix(ref, "#/"), "/") {
+		m, ok := node.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		node = m[key]
+	}
+	resolved, _ := node.(map[string]interface{})
+	return resolved
+}
+
+// strictがfalseの場合は未定義のフィールドを確認しない（allOfの各スキーマは一部のフィールドしか定義しないため）
+func (s *jsonSchema) validate(schema map[string]interface{}, value interface{}, path string, strict bool) []error {
+	if ref, ok := schema["$ref"].(string); ok {
+		resolved := s.resolve(ref)
+		if resolved == nil {
+			return []error{fmt.Errorf("%s: unresolved $ref %s", path, ref)}
+		}
+		return s.validate(resolved, value, path, strict)
+	}
+
+	var errs []error
+
+	if allOf, ok := schema["allOf"].([]interface{}); ok {
+		for _, sub := range allOf {
+			errs = append(errs, s.validate(sub.(map[string]interface{}), value, path, false)...)
+		}
+	}
+
+	if oneOf, ok := schema["oneOf"].([]interface{}); ok {
+		matched := 0
+		var closest []error
+		for _, sub := range oneOf {
+			subErrs := s.validate(sub.(map[string]interface{}), value, path, strict)
+			if len(subErrs) == 0 {
+				matched++
+			} else if closest == nil || len(subErrs) < len(closest) {
+				closest = subErrs
+			}
+		}
+		switch {
+		case matched == 0:
+			// 最も近いスキーマとの差分を報告する
+			errs = append(errs, closest...)
+		case matched > 1:
+			errs = append(errs, fmt.Errorf("%s: matches %d schemas in oneOf, want 1", path, matched))
+		}
+	}
+
+	if typ, ok := schema["type"].(string); ok && !hasJSONType(value, typ) {
+		return append(errs, fmt.Errorf("%s: %v is not %s", path, value, typ))
+	}
+
+	if c, ok := schema["const"]; ok && !reflect.DeepEqual(c, value) {
+		errs = append(errs, fmt.Errorf("%s: %v, want %v", path, value, c))
+	}
+
+	if enum, ok := schema["enum"].([]interface{}); ok && !containsValue(enum, value) {
+		errs = append(errs, fmt.Errorf("%s: %v is not one of %v", path, value, enum))
+	}
+
+	if minimum, ok := schema["minimum"].(float64); ok {
+		if n, ok := value.(float64); ok && n < minimum {
+			errs = append(errs, fmt.Errorf("%s: %v is less than %v", path, n, minimum))
+		}
+	}
+
+	if object, ok := value.(map[string]interface{}); ok {
+		if required, ok := schema["required"].([]interface{}); ok {
+			for _, key := range required {
+				if _, ok := object[key.(string)]; !ok {
+					errs = append(errs, fmt.Errorf("%s: missing required field %q", path, key))
+				}
+			}
+		}
+
+		properties, _ := schema["properties"].(map[string]interface{})
+		for key, sub := range properties {
+			if v, ok := object[key]; ok {
+				errs = append(errs, s.validate(sub.(map[string]interface{}), v, path+"."+key, true)...)
+			}
+		}
+
+		// 型がobjectのスキーマでは、出力したフィールドがすべて定義されていること
+		if declared := s.declaredProperties(schema); strict && declared != nil {
+			var undeclared []string
+			for key := range object {
+				if !declared[key] {
+					undeclared = append(undeclared, key)
+				}
+			}
+			sort.Strings(undeclared)
+			for _, key := range undeclared {
+				errs = append(errs, fmt.Errorf("%s: field %q is not defined in the schema", path, key))
+			}
+		}
+	}
+
+	if array, ok := value.([]interface{}); ok {
+		if items, ok := schema["items"].(map[string]interface{}); ok {
+			for i, item := range array {
+				errs = append(errs, s.validate(items, item, fmt.Sprintf("%s[%d]", path, i), true)...)
+			}
+		}
+	}
+
+	return errs
+}
+
+// declaredProperties はスキーマ（allOfを含む）で定義されたフィールド名を返す
+// フィールドを定義していないスキーマ（expected, actual 等）ではnilを返す
+func (s *jsonSchema) declaredProperties(schema map[string]interface{}) map[string]bool {
+	if ref, ok := schema["$ref"].(string); ok {
+		return s.declaredProperties(s.resolve(ref))
+	}
+
+	var declared map[string]bool
+	if properties, ok := schema["properties"].(map[string]interface{}); ok {
+		declared = map[string]bool{}
+		for key := range properties {
+			declared[key] = true
+		}
+	}
+	if allOf, ok := schema["allOf"].([]interface{}); ok {
+		for _, sub := range allOf {
+			for key := range s.declaredProperties(sub.(map[string]interface{})) {
+				if declared == nil {
+					declared = map[string]bool{}
+				}
+				declared[key] = true
+			}
+		}
+	}
+	return declared
+}
+
+func hasJSONType(value interface{}, typ string) bool {
+	switch typ {
+	case "object":
+		_, ok := value.(map[string]interface{})
+		return ok
+	case "array":
+		_, ok := value.([]interface{})
+		return ok
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "integer":
+		n, ok := value.(float64)
+		return ok && n == float64(int64(n))
+	case "number":
+		_, ok := value.(float64)
+		return ok
+	case "null":
+		return value == nil
+	default:
+		return false
+	}
+}
+
+func containsValue(values []interface{}, value interface{}) bool {
+	for _, v := range values {
+		if reflect.DeepEqual(v, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,257 @@
+package reporter
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sbcntr2-test-tool/internal/i18n"
+	"sbcntr2-test-tool/internal/validator"
+	"strings"
+	"time"
+)
+
+// JUnitReporter はCIで集計できるようにJUnit XML形式で出力する
+// ステップをtestsuite、リソースの存在確認・検証ルール・通信経路をtestcaseに対応させる
+type JUnitReporter struct {
+	out io.Writer
+}
+
+func NewJUnitReporter(out io.Writer) *JUnitReporter {
+	return &JUnitReporter{out: out}
+}
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Errors   int              `xml:"errors,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+	Time     string           `xml:"time,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Errors    int             `xml:"errors,attr"`
+	Skipped   int             `xml:"skipped,attr"`
+	Time      string          `xml:"time,attr"`
+	Cases     []junitTestCase `xml:"testcase"`
+	SystemOut string          `xml:"system-out,omitempty"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Error     *junitMessage `xml:"error,omitempty"`
+	Skipped   *junitMessage `xml:"skipped,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr,omitempty"`
+	Type    string `xml:"type,attr,omitempty"`
+	Body    string `xml:",chardata"`
+}
+
+func (r *JUnitReporter) ReportResult(result *validator.ValidationResult) error {
+	suite := r.formatResult(result)
+	return r.write(junitTestSuites{
+		Name:     "sbcntr-validator",
+		Tests:    suite.Tests,
+		Failures: suite.Failures,
+		Errors:   suite.Errors,
+		Skipped:  suite.Skipped,
+		Time:     suite.Time,
+		Suites:   []junitTestSuite{suite},
+	})
+}
+
+func (r *JUnitReporter) ReportSummary(summary *validator.ValidationSummary) error {
+	suites := junitTestSuites{
+		Name:   "sbcntr-validator",
+		Suites: []junitTestSuite{},
+	}
+
+	var total time.Duration
+	for _, result := range summary.Results {
+		suite := r.formatResult(&result)
+		suites.Suites = append(suites.Suites, suite)
+		suites.Tests += suite.Tests
+		suites.Failures += suite.Failures
+		suites.Errors += suite.Errors
+		suites.Skipped += suite.Skipped
+		total += result.Duration
+	}
+	suites.Time = junitSeconds(total)
+
+	return r.write(suites)
+}
+
+func (r *JUnitReporter) write(suites junitTestSuites) error {
+	output, err := xml.MarshalIndent(suites, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode JUnit XML: %w", err)
+	}
+
+	if _, err := fmt.Fprintf(r.out, "%s%s\n", xml.Header, output); err != nil {
+		return fmt.Errorf("failed to write JUnit XML: %w", err)
+	}
+
+	return nil
+}
+
+func (r *JUnitReporter) formatResult(result *validator.ValidationResult) junitTestSuite {
+	suite := junitTestSuite{
+		Name:  fmt.Sprintf("Step %d: %s", result.StepNumber, result.StepName),
+		Time:  junitSeconds(result.Duration),
+		Cases: []junitTestCase{},
+	}
+	stepClass := fmt.Sprintf("step%d", result.StepNumber)
+
+	for _, resource := range result.Resources {
+		className := fmt.Sprintf("%s.%s.%s", stepClass, resource.Type, resource.Name)
+		suite.Cases = append(suite.Cases, r.resourceCase(resource, className))
+
+		for _, rule := range resource.Rules {
+			suite.Cases = append(suite.Cases, r.ruleCase(rule, className))
+		}
+	}
+
+	for _, flow := range result.Flows {
+		testCase := junitTestCase{
+			Name:      fmt.Sprintf("%s -> %s (%s/%d)", flow.From, flow.To, flow.Protocol, flow.Port),
+			ClassName: stepClass + ".flows",
+			Time:      junitSeconds(0),
+		}
+		if flow.Indeterminate {
+			testCase.Skipped = &junitMessage{Message: flow.Reason}
+		} else if !flow.Reachable {
+			body := flow.Reason
+			if flow.BlockingHop != "" {
+				body = i18n.T("report.blocked_at_reason", flow.BlockingHop, flow.Reason)
+			}
+			testCase.Failure = &junitMessage{Message: body, Type: "unreachable", Body: body}
+		}
+		suite.Cases = append(suite.Cases, testCase)
+	}
+
+	// リソースに紐づかないエラー（CloudFormationスタックが存在しない等）はテストケースにする
+	for _, err := range result.Errors {
+		if isResourceError(result, err) {
+			continue
+		}
+		body := err.Message
+		if err.Suggestion != "" {
+			body += "\n" + i18n.T("report.suggestion_line", err.Suggestion)
+		}
+		suite.Cases = append(suite.Cases, junitTestCase{
+			Name:      err.Resource,
+			ClassName: stepClass + ".errors",
+			Time:      junitSeconds(0),
+			Failure:   &junitMessage{Message: err.Message, Type: "error", Body: body},
+		})
+	}
+
+	if result.Status == validator.StatusSkipped {
+		suite.Cases = append(suite.Cases, junitTestCase{
+			Name:      "step",
+			ClassName: stepClass,
+			Time:      junitSeconds(0),
+			Skipped:   &junitMessage{Message: i18n.T("report.step_skipped")},
+		})
+	}
+
+	var warnings []string
+	for _, warn := range result.Warnings {
+		warnings = append(warnings, fmt.Sprintf("%s: %s", warn.Resource, warn.Message))
+	}
+	suite.SystemOut = strings.Join(warnings, "\n")
+
+	for _, testCase := range suite.Cases {
+		suite.Tests++
+		switch {
+		case testCase.Failure != nil:
+			suite.Failures++
+		case testCase.Error != nil:
+			suite.Errors++
+		case testCase.Skipped != nil:
+			suite.Skipped++
+		}
+	}
+
+	return suite
+}
+
+// resourceCase はリソースの存在確認をテストケースにする
+// 必須でないリソースが見つからない場合はスキップとして扱う
+func (r *JUnitReporter) resourceCase(resource validator.ResourceResult, className string) junitTestCase {
+	testCase := junitTestCase{
+		Name:      "exists",
+		ClassName: className,
+		Time:      junitSeconds(resource.Duration),
+	}
+
+	if resource.Status == validator.ResourceNotFound {
+		message := i18n.T("report.resource_not_found", resource.Name)
+		if len(resource.Errors) > 0 {
+			// AWS APIの呼び出しに失敗した場合
+			testCase.Error = &junitMessage{Message: message, Type: "error", Body: strings.Join(resource.Errors, "\n")}
+		} else if !resource.Required {
+			testCase.Skipped = &junitMessage{Message: message}
+		} else {
+			testCase.Failure = &junitMessage{Message: message, Type: "not_found", Body: message}
+		}
+	}
+
+	return testCase
+}
+
+// ruleCase は検証ルールの結果をテストケースにする
+// severityがerror以外のルールは失敗してもテストケースとしては成功とし、警告をsystem-outに出力する
+func (r *JUnitReporter) ruleCase(rule validator.RuleResult, className string) junitTestCase {
+	testCase := junitTestCase{
+		Name:      rule.Name,
+		ClassName: className,
+		Time:      junitSeconds(0),
+	}
+
+	if rule.Passed {
+		return testCase
+	}
+
+	body := fmt.Sprintf("property: %s\nexpected: %v\nactual: %v", rule.Property, rule.Expected, rule.Actual)
+	if rule.Severity == "error" {
+		testCase.Failure = &junitMessage{Message: rule.Message, Type: rule.Severity, Body: body}
+	} else {
+		testCase.SystemOut = fmt.Sprintf("%s: %s\n%s", rule.Severity, rule.Message, body)
+	}
+
+	return testCase
+}
+
+// isResourceError はエラーがリソースの存在確認または通信経路の結果として報告済みかを判定する
+func isResourceError(result *validator.ValidationResult, err validator.ValidationError) bool {
+	if err.Type == validator.ErrorNetworkFailure {
+		return true
+	}
+	for _, flow := range result.Flows {
+		if strings.HasPrefix(err.Resource, fmt.Sprintf("%s -> %s ", flow.From, flow.To)) {
+			return true
+		}
+	}
+	for _, resource := range result.Resources {
+		if resource.Name == err.Resource && resource.Status == validator.ResourceNotFound {
+			return true
+		}
+	}
+	return false
+}
+
+func junitSeconds(d time.Duration) string {
+	return fmt.Sprintf("%.3f", d.Seconds())
+}
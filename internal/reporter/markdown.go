@@ -0,0 +1,247 @@
+package reporter
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sbcntr2-test-tool/internal/i18n"
+	"sbcntr2-test-tool/internal/validator"
+	"strings"
+	"time"
+)
+
+// MarkdownReporter はチケットやメールに添付できるMarkdown形式のレポートを出力する
+type MarkdownReporter struct {
+	out io.Writer
+}
+
+func NewMarkdownReporter(out io.Writer) *MarkdownReporter {
+	return &MarkdownReporter{out: out}
+}
+
+func (r *MarkdownReporter) ReportResult(result *validator.ValidationResult) error {
+	var b strings.Builder
+	b.WriteString("# " + i18n.T("report.title") + "\n\n")
+	r.writeStep(&b, result)
+	return r.write(b.String())
+}
+
+func (r *MarkdownReporter) ReportSummary(summary *validator.ValidationSummary) error {
+	var b strings.Builder
+	b.WriteString("# " + i18n.T("report.title") + "\n\n")
+
+	b.WriteString("## " + i18n.T("report.summary") + "\n\n")
+	fmt.Fprintf(&b, "| %s | ✅ %s | ❌ %s | ⏭️ %s |\n", i18n.T("report.total"), i18n.T("report.passed"), i18n.T("report.failed"), i18n.T("report.skipped"))
+	b.WriteString("|------:|------:|------:|------:|\n")
+	fmt.Fprintf(&b, "| %d | %d | %d | %d |\n\n", summary.TotalSteps, summary.PassedSteps, summary.FailedSteps, summary.SkippedSteps)
+
+	fmt.Fprintf(&b, "| %s | %s | %s | %s |\n", i18n.T("report.step"), i18n.T("report.name"), i18n.T("report.status"), i18n.T("report.duration"))
+	b.WriteString("|-----:|------|--------|---------:|\n")
+	for _, result := range summary.Results {
+		fmt.Fprintf(&b, "| %d | %s | %s | %s |\n", result.StepNumber, markdownCell(result.StepName), statusLabel(result.Status), result.Duration.Round(time.Millisecond))
+	}
+	b.WriteString("\n")
+
+	for _, result := range summary.Results {
+		r.writeStep(&b, &result)
+	}
+
+	return r.write(b.String())
+}
+
+func (r *MarkdownReporter) write(report string) error {
+	if _, err := fmt.Fprint(r.out, report); err != nil {
+		return fmt.Errorf("failed to write Markdown report: %w", err)
+	}
+	return nil
+}
+
+func (r *MarkdownReporter) writeStep(b *strings.Builder, result *validator.ValidationResult) {
+	fmt.Fprintf(b, "## %s\n\n", i18n.T("report.step_title", result.StepNumber, result.StepName))
+	fmt.Fprintf(b, "**%s:** %s  \n**%s:** %s\n\n", i18n.T("report.status"), statusLabel(result.Status), i18n.T("report.duration"), result.Duration.Round(time.Millisecond))
+
+	if len(result.Resources) > 0 {
+		b.WriteString("### " + i18n.T("report.resources") + "\n\n")
+		fmt.Fprintf(b, "| %s | %s | %s | %s |\n", i18n.T("report.status"), i18n.T("report.name"), i18n.T("report.type"), i18n.T("report.stack"))
+		b.WriteString("|--------|------|------|-------|\n")
+		for _, resource := range result.Resources {
+			stack := ""
+			if resource.StackName != "" {
+				stack = fmt.Sprintf("%s/%s", resource.StackName, resource.LogicalID)
+			}
+			fmt.Fprintf(b, "| %s | %s | `%s` | %s |\n", resourceStatusLabel(resource.Status), markdownCell(resource.Name), resource.Type, markdownCell(stack))
+		}
+		b.WriteString("\n")
+
+		b.WriteString("### " + i18n.T("report.rules") + "\n\n")
+		fmt.Fprintf(b, "| %s | %s | %s | %s | %s | %s | %s |\n", i18n.T("report.result"), i18n.T("report.resource"), i18n.T("report.rule"),
+			i18n.T("report.property"), i18n.T("report.expected"), i18n.T("report.actual"), i18n.T("report.message"))
+		b.WriteString("|--------|----------|------|----------|----------|--------|---------|\n")
+		for _, resource := range result.Resources {
+			for _, rule := range resource.Rules {
+				fmt.Fprintf(b, "| %s | %s | %s | %s | %s | %s | %s |\n",
+					ruleLabel(rule), markdownCell(resource.Name), markdownCode(rule.Name), markdownCode(markdownCell(rule.Property)),
+					markdownCell(formatValue(rule.Expected)), markdownCell(formatValue(rule.Actual)), markdownCell(rule.Message))
+			}
+		}
+		b.WriteString("\n")
+	}
+
+	if len(result.Errors) > 0 {
+		b.WriteString("### " + i18n.T("report.errors") + "\n\n")
+		for _, err := range result.Errors {
+			fmt.Fprintf(b, "- ❌ %s\n", err.Message)
+			if err.Suggestion != "" {
+				fmt.Fprintf(b, "  - 💡 %s\n", err.Suggestion)
+			}
+			if err.DocumentRef != "" {
+				fmt.Fprintf(b, "  - 📖 %s\n", markdownLink(err.DocumentRef))
+			}
+		}
+		b.WriteString("\n")
+	}
+
+	// 失敗したルールの修正方法
+	var suggestions []string
+	for _, resource := range result.Resources {
+		for _, rule := range resource.Rules {
+			if rule.Passed || (rule.Suggestion == "" && rule.DocumentRef == "") {
+				continue
+			}
+			line := fmt.Sprintf("- `%s` (%s)", rule.Name, resource.Name)
+			if rule.Suggestion != "" {
+				line += ": " + rule.Suggestion
+			}
+			if rule.DocumentRef != "" {
+				line += " 📖 " + markdownLink(rule.DocumentRef)
+			}
+			suggestions = append(suggestions, line)
+		}
+	}
+	if len(suggestions) > 0 {
+		b.WriteString("### " + i18n.T("report.suggestions") + "\n\n")
+		b.WriteString(strings.Join(suggestions, "\n"))
+		b.WriteString("\n\n")
+	}
+
+	if len(result.Warnings) > 0 {
+		b.WriteString("### " + i18n.T("report.warnings") + "\n\n")
+		for _, warn := range result.Warnings {
+			fmt.Fprintf(b, "- ⚠️ %s: %s\n", warn.Resource, warn.Message)
+		}
+		b.WriteString("\n")
+	}
+
+	if len(result.Flows) > 0 {
+		b.WriteString("### " + i18n.T("report.reachability") + "\n\n")
+		fmt.Fprintf(b, "| %s | %s | %s | %s | %s | %s |\n", i18n.T("report.result"), i18n.T("report.from"), i18n.T("report.to"),
+			i18n.T("report.port"), i18n.T("report.blocked_at"), i18n.T("report.reason"))
+		b.WriteString("|--------|------|----|------|------------|--------|\n")
+		for _, flow := range result.Flows {
+			icon := "✅"
+			if flow.Indeterminate {
+				icon = "⚠️"
+			} else if !flow.Reachable {
+				icon = "❌"
+			}
+			fmt.Fprintf(b, "| %s | %s | %s | %s/%d | %s | %s |\n", icon, markdownCell(flow.From), markdownCell(flow.To),
+				flow.Protocol, flow.Port, markdownCell(flow.BlockingHop), markdownCell(flow.Reason))
+		}
+		b.WriteString("\n")
+	}
+
+	if len(result.Drifts) > 0 {
+		b.WriteString("### " + i18n.T("report.drift") + "\n\n")
+		fmt.Fprintf(b, "| %s | %s | %s | %s |\n", i18n.T("report.stack"), i18n.T("report.logical_id"), i18n.T("report.type"), i18n.T("report.drift_status"))
+		b.WriteString("|-------|------------|------|-------|\n")
+		for _, drift := range result.Drifts {
+			for _, res := range drift.Resources {
+				if res.DriftStatus == "IN_SYNC" {
+					continue
+				}
+				fmt.Fprintf(b, "| %s | %s | `%s` | %s |\n", markdownCell(drift.StackName), markdownCell(res.LogicalID), res.Type, res.DriftStatus)
+			}
+		}
+		b.WriteString("\n")
+	}
+}
+
+// formatValue は期待値・実際の値を表示用の文字列にする。mapやスライスはJSONにする
+func formatValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case map[string]interface{}, []interface{}, []map[string]interface{}, []string:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprintf("%v", v)
+		}
+		return string(data)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+// markdownCell は表のセルで崩れないようにパイプ、HTMLタグ、改行をエスケープする
+func markdownCell(value string) string {
+	value = strings.NewReplacer("|", `\|`, "<", "&lt;", ">", "&gt;").Replace(value)
+	return strings.ReplaceAll(value, "\n", "<br>")
+}
+
+func markdownCode(value string) string {
+	if value == "" {
+		return ""
+	}
+	return "`" + value + "`"
+}
+
+func markdownLink(ref string) string {
+	if isURL(ref) {
+		return fmt.Sprintf("[%s](%s)", ref, ref)
+	}
+	return ref
+}
+
+func isURL(ref string) bool {
+	return strings.HasPrefix(ref, "http://") || strings.HasPrefix(ref, "https://")
+}
+
+func statusLabel(status validator.ValidationStatus) string {
+	switch status {
+	case validator.StatusPassed:
+		return "✅ " + i18n.T("status.passed")
+	case validator.StatusFailed:
+		return "❌ " + i18n.T("status.failed")
+	case validator.StatusWarning:
+		return "⚠️ " + i18n.T("status.warning")
+	case validator.StatusSkipped:
+		return "⏭️ " + i18n.T("status.skipped")
+	default:
+		return "⏸️ " + i18n.T("status.pending")
+	}
+}
+
+func resourceStatusLabel(status validator.ResourceStatus) string {
+	switch status {
+	case validator.ResourceExists:
+		return "✅ " + i18n.T("status.exists")
+	case validator.ResourceNotFound:
+		return "❌ " + i18n.T("status.not_found")
+	case validator.ResourceMisconfigured:
+		return "⚠️ " + i18n.T("status.misconfigured")
+	default:
+		return "⏸️ " + i18n.T("status.pending")
+	}
+}
+
+func ruleLabel(rule validator.RuleResult) string {
+	if rule.Passed {
+		return "✅ " + i18n.T("status.pass")
+	}
+	if rule.Severity == "error" {
+		return "❌ " + i18n.T("status.fail")
+	}
+	return "⚠️ " + rule.Severity
+}
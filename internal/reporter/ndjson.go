@@ -0,0 +1,194 @@
+package reporter
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sbcntr2-test-tool/internal/validator"
+	"sync"
+	"time"
+)
+
+// NDJSONReporter は検証の進行を1行1イベントのJSON（NDJSON）で出力する
+// 検証エンジンのオブザーバーとして登録し、イベントが発生するたびに書き出す
+type NDJSONReporter struct {
+	out      io.Writer
+	mu       sync.Mutex
+	sequence int
+	err      error
+}
+
+func NewNDJSONReporter(out io.Writer) *NDJSONReporter {
+	return &NDJSONReporter{out: out}
+}
+
+// ndjsonEvent は1行分のイベント
+// eventIdは実行内で一意で、runId・stepId・resourceId・ruleIdでイベント同士を関連付けられる
+type ndjsonEvent struct {
+	Event      string      `json:"event"`
+	EventID    string      `json:"eventId"`
+	Sequence   int         `json:"sequence"`
+	Timestamp  time.Time   `json:"timestamp"`
+	RunID      string      `json:"runId"`
+	StepID     string      `json:"stepId,omitempty"`
+	ResourceID string      `json:"resourceId,omitempty"`
+	RuleID     string      `json:"ruleId,omitempty"`
+	Data       interface{} `json:"data"`
+}
+
+// ReportResult はイベントを出力済みのため、書き込み中に発生したエラーだけを返す
+func (r *NDJSONReporter) ReportResult(result *validator.ValidationResult) error {
+	return r.writeError()
+}
+
+// ReportSummary はイベントを出力済みのため、書き込み中に発生したエラーだけを返す
+func (r *NDJSONReporter) ReportSummary(summary *validator.ValidationSummary) error {
+	return r.writeError()
+}
+
+func (r *NDJSONReporter) RunStarted(run validator.RunInfo) {
+	r.emit(ndjsonEvent{
+		Event: "run_started",
+		RunID: run.ID,
+		Data: map[string]interface{}{
+			"steps":     run.Steps,
+			"startedAt": run.StartedAt,
+		},
+	})
+}
+
+func (r *NDJSONReporter) StepStarted(run validator.RunInfo, stepNumber int, stepName string) {
+	r.emit(ndjsonEvent{
+		Event:  "step_started",
+		RunID:  run.ID,
+		StepID: stepID(stepNumber),
+		Data: map[string]interface{}{
+			"stepNumber": stepNumber,
+			"stepName":   stepName,
+		},
+	})
+}
+
+func (r *NDJSONReporter) RuleEvaluated(run validator.RunInfo, stepNumber int, resource *validator.ResourceResult, rule validator.RuleResult) {
+	resID := resourceID(stepNumber, resource)
+	r.emit(ndjsonEvent{
+		Event:      "rule_evaluated",
+		RunID:      run.ID,
+		StepID:     stepID(stepNumber),
+		ResourceID: resID,
+		RuleID:     resID + "/" + rule.Name,
+		Data: map[string]interface{}{
+			"name":     rule.Name,
+			"type":     rule.Type,
+			"property": rule.Property,
+			"operator": rule.Operator,
+			"expected": rule.Expected,
+			"actual":   rule.Actual,
+			"severity": rule.Severity,
+			"passed":   rule.Passed,
+			"message":  rule.Message,
+		},
+	})
+}
+
+func (r *NDJSONReporter) ResourceChecked(run validator.RunInfo, stepNumber int, resource *validator.ResourceResult) {
+	passed := 0
+	for _, rule := range resource.Rules {
+		if rule.Passed {
+			passed++
+		}
+	}
+
+	r.emit(ndjsonEvent{
+		Event:      "resource_checked",
+		RunID:      run.ID,
+		StepID:     stepID(stepNumber),
+		ResourceID: resourceID(stepNumber, resource),
+		Data: map[string]interface{}{
+			"type":        resource.Type,
+			"id":          resource.ID,
+			"name":        resource.Name,
+			"status":      resource.Status.String(),
+			"passedRules": passed,
+			"totalRules":  len(resource.Rules),
+			"errors":      resource.Errors,
+			"warnings":    resource.Warnings,
+			"durationMs":  resource.Duration.Milliseconds(),
+		},
+	})
+}
+
+func (r *NDJSONReporter) StepFinished(run validator.RunInfo, result *validator.ValidationResult) {
+	errors := make([]string, 0, len(result.Errors))
+	for _, err := range result.Errors {
+		errors = append(errors, err.Message)
+	}
+	warnings := make([]string, 0, len(result.Warnings))
+	for _, warn := range result.Warnings {
+		warnings = append(warnings, fmt.Sprintf("%s: %s", warn.Resource, warn.Message))
+	}
+
+	r.emit(ndjsonEvent{
+		Event:  "step_finished",
+		RunID:  run.ID,
+		StepID: stepID(result.StepNumber),
+		Data: map[string]interface{}{
+			"stepNumber": result.StepNumber,
+			"stepName":   result.StepName,
+			"status":     result.Status.String(),
+			"resources":  len(result.Resources),
+			"errors":     errors,
+			"warnings":   warnings,
+			"durationMs": result.Duration.Milliseconds(),
+		},
+	})
+}
+
+func (r *NDJSONReporter) RunFinished(run validator.RunInfo, summary *validator.ValidationSummary) {
+	r.emit(ndjsonEvent{
+		Event: "run_finished",
+		RunID: run.ID,
+		Data: map[string]interface{}{
+			"totalSteps":   summary.TotalSteps,
+			"passedSteps":  summary.PassedSteps,
+			"failedSteps":  summary.FailedSteps,
+			"skippedSteps": summary.SkippedSteps,
+			"durationMs":   time.Since(run.StartedAt).Milliseconds(),
+		},
+	})
+}
+
+// emit はイベントに連番と時刻をつけて1行で書き出す。最初に失敗したエラーを保持する
+func (r *NDJSONReporter) emit(event ndjsonEvent) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.err != nil {
+		return
+	}
+
+	r.sequence++
+	event.Sequence = r.sequence
+	event.EventID = fmt.Sprintf("%s-%d", event.RunID, r.sequence)
+	event.Timestamp = time.Now()
+
+	if err := json.NewEncoder(r.out).Encode(event); err != nil {
+		r.err = fmt.Errorf("failed to write NDJSON event: %w", err)
+	}
+}
+
+func (r *NDJSONReporter) writeError() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.err
+}
+
+func stepID(stepNumber int) string {
+	return fmt.Sprintf("step-%d", stepNumber)
+}
+
+// resourceID はステップ内のリソースを識別するID（step-N/リソースタイプ/名前）
+func resourceID(stepNumber int, resource *validator.ResourceResult) string {
+	return fmt.Sprintf("%s/%s/%s", stepID(stepNumber), resource.Type, resource.Name)
+}
@@ -0,0 +1,334 @@
+package reporter
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sbcntr2-test-tool/internal/i18n"
+	"sbcntr2-test-tool/internal/validator"
+	"strings"
+)
+
+const (
+	sarifVersion = "2.1.0"
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+
+	// 検証ルール以外で報告する結果のruleId
+	sarifRuleResourceExists = "resource_exists"
+	sarifRuleFlowReachable  = "flow_reachable"
+	sarifRuleStepError      = "step_error"
+)
+
+// SARIFReporter はコードスキャンのUIに表示できるようにSARIF 2.1.0形式で出力する
+// 失敗した検証ルールごとに、ルール名をruleIdとした結果を出力する
+type SARIFReporter struct {
+	out io.Writer
+}
+
+func NewSARIFReporter(out io.Writer) *SARIFReporter {
+	return &SARIFReporter{out: out}
+}
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name  string      `json:"name"`
+	Rules []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID                   string                 `json:"id"`
+	ShortDescription     sarifMessage           `json:"shortDescription"`
+	Help                 *sarifMessage          `json:"help,omitempty"`
+	HelpURI              string                 `json:"helpUri,omitempty"`
+	DefaultConfiguration sarifConfiguration     `json:"defaultConfiguration"`
+	Properties           map[string]interface{} `json:"properties,omitempty"`
+}
+
+type sarifConfiguration struct {
+	Level string `json:"level"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID     string                 `json:"ruleId"`
+	RuleIndex  int                    `json:"ruleIndex"`
+	Level      string                 `json:"level"`
+	Message    sarifMessage           `json:"message"`
+	Locations  []sarifLocation        `json:"locations"`
+	Properties map[string]interface{} `json:"properties,omitempty"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation *sarifPhysicalLocation `json:"physicalLocation,omitempty"`
+	LogicalLocations []sarifLogicalLocation `json:"logicalLocations,omitempty"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifLogicalLocation struct {
+	Name               string `json:"name"`
+	FullyQualifiedName string `json:"fullyQualifiedName,omitempty"`
+	Kind               string `json:"kind"`
+}
+
+// sarifBuilder はルール定義の重複を除きながら結果を組み立てる
+type sarifBuilder struct {
+	rules     []sarifRule
+	ruleIndex map[string]int
+	results   []sarifResult
+}
+
+func (r *SARIFReporter) ReportResult(result *validator.ValidationResult) error {
+	builder := newSARIFBuilder()
+	builder.addResult(result)
+	return r.write(builder.log())
+}
+
+func (r *SARIFReporter) ReportSummary(summary *validator.ValidationSummary) error {
+	builder := newSARIFBuilder()
+	for _, result := range summary.Results {
+		builder.addResult(&result)
+	}
+	return r.write(builder.log())
+}
+
+func (r *SARIFReporter) write(log sarifLog) error {
+	encoder := json.NewEncoder(r.out)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(log); err != nil {
+		return fmt.Errorf("failed to encode SARIF: %w", err)
+	}
+
+	return nil
+}
+
+func newSARIFBuilder() *sarifBuilder {
+	return &sarifBuilder{
+		rules:     []sarifRule{},
+		ruleIndex: make(map[string]int),
+		results:   []sarifResult{},
+	}
+}
+
+func (b *sarifBuilder) log() sarifLog {
+	return sarifLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs: []sarifRun{
+			{
+				Tool: sarifTool{
+					Driver: sarifDriver{
+						Name:  "sbcntr-validator",
+						Rules: b.rules,
+					},
+				},
+				Results: b.results,
+			},
+		},
+	}
+}
+
+func (b *sarifBuilder) addResult(result *validator.ValidationResult) {
+	stepFile := fmt.Sprintf("internal/config/configs/steps/step%d.yaml", result.StepNumber)
+
+	for _, resource := range result.Resources {
+		locations := sarifResourceLocations(resource, stepFile)
+		properties := map[string]interface{}{
+			"step":         result.StepNumber,
+			"resourceType": resource.Type,
+			"resourceName": resource.Name,
+		}
+
+		if resource.Status == validator.ResourceNotFound && resource.Required {
+			index := b.rule(sarifRuleResourceExists, i18n.T("report.rule_resource_exists"), "error",
+				i18n.T("report.rule_resource_exists.help"), i18n.T("engine.step_ref", result.StepNumber))
+			b.results = append(b.results, sarifResult{
+				RuleID:     sarifRuleResourceExists,
+				RuleIndex:  index,
+				Level:      "error",
+				Message:    sarifMessage{Text: i18n.T("report.required_resource_not_found", resource.Name, resource.Type)},
+				Locations:  locations,
+				Properties: properties,
+			})
+			continue
+		}
+
+		for _, rule := range resource.Rules {
+			if rule.Passed {
+				continue
+			}
+
+			level := sarifLevel(rule.Severity)
+			index := b.rule(rule.Name, rule.ErrorMessage, level, rule.Suggestion, rule.DocumentRef)
+
+			ruleProperties := map[string]interface{}{
+				"property": rule.Property,
+				"expected": rule.Expected,
+				"actual":   rule.Actual,
+			}
+			for key, value := range properties {
+				ruleProperties[key] = value
+			}
+
+			b.results = append(b.results, sarifResult{
+				RuleID:     rule.Name,
+				RuleIndex:  index,
+				Level:      level,
+				Message:    sarifMessage{Text: fmt.Sprintf("%s (%s)", rule.Message, resource.Name)},
+				Locations:  locations,
+				Properties: ruleProperties,
+			})
+		}
+	}
+
+	for _, flow := range result.Flows {
+		if flow.Reachable {
+			continue
+		}
+
+		index := b.rule(sarifRuleFlowReachable, i18n.T("report.rule_flow_reachable"), "error",
+			i18n.T("report.rule_flow_reachable.help"), i18n.T("engine.step_ref", result.StepNumber))
+		// 判定できなかった通信経路は警告にする
+		level := "error"
+		if flow.Indeterminate {
+			level = "warning"
+		}
+		message := flow.Reason
+		if flow.BlockingHop != "" {
+			message = i18n.T("report.blocked_at_reason", flow.BlockingHop, flow.Reason)
+		}
+		b.results = append(b.results, sarifResult{
+			RuleID:    sarifRuleFlowReachable,
+			RuleIndex: index,
+			Level:     level,
+			Message:   sarifMessage{Text: fmt.Sprintf("%s -> %s (%s/%d) %s", flow.From, flow.To, flow.Protocol, flow.Port, message)},
+			Locations: []sarifLocation{
+				{PhysicalLocation: &sarifPhysicalLocation{ArtifactLocation: sarifArtifactLocation{URI: stepFile}}},
+			},
+			Properties: map[string]interface{}{
+				"step":        result.StepNumber,
+				"blockingHop": flow.BlockingHop,
+			},
+		})
+	}
+
+	// リソースや通信経路に紐づかないエラー（CloudFormationスタックが存在しない等）
+	for _, err := range result.Errors {
+		if isResourceError(result, err) {
+			continue
+		}
+
+		index := b.rule(sarifRuleStepError, i18n.T("report.rule_step_error"), "error",
+			i18n.T("report.rule_step_error.help"), i18n.T("engine.step_ref", result.StepNumber))
+		properties := map[string]interface{}{
+			"step":      result.StepNumber,
+			"resource":  err.Resource,
+			"errorType": err.Type.String(),
+		}
+		if err.Suggestion != "" {
+			properties["suggestion"] = err.Suggestion
+		}
+		b.results = append(b.results, sarifResult{
+			RuleID:    sarifRuleStepError,
+			RuleIndex: index,
+			Level:     "error",
+			Message:   sarifMessage{Text: err.Message},
+			Locations: []sarifLocation{
+				{PhysicalLocation: &sarifPhysicalLocation{ArtifactLocation: sarifArtifactLocation{URI: stepFile}}},
+			},
+			Properties: properties,
+		})
+	}
+}
+
+// rule はルール定義を登録し、そのインデックスを返す。同じruleIdは最初の定義を使う
+func (b *sarifBuilder) rule(id, description, level, suggestion, documentRef string) int {
+	if index, ok := b.ruleIndex[id]; ok {
+		return index
+	}
+
+	rule := sarifRule{
+		ID:                   id,
+		ShortDescription:     sarifMessage{Text: description},
+		DefaultConfiguration: sarifConfiguration{Level: level},
+	}
+	if rule.ShortDescription.Text == "" {
+		rule.ShortDescription.Text = id
+	}
+
+	var help []string
+	if suggestion != "" {
+		help = append(help, suggestion)
+	}
+	if documentRef != "" {
+		help = append(help, i18n.T("report.reference_line", documentRef))
+		if isURL(documentRef) {
+			rule.HelpURI = documentRef
+		}
+	}
+	if len(help) > 0 {
+		rule.Help = &sarifMessage{Text: strings.Join(help, "\n")}
+	}
+
+	b.rules = append(b.rules, rule)
+	b.ruleIndex[id] = len(b.rules) - 1
+	return b.ruleIndex[id]
+}
+
+// sarifResourceLocations はリソースを宣言したステップ定義ファイルを物理的な位置とし、
+// CloudFormationスタックで作成されたリソースには論理的な位置（スタック/論理ID）を加える
+func sarifResourceLocations(resource validator.ResourceResult, stepFile string) []sarifLocation {
+	location := sarifLocation{
+		PhysicalLocation: &sarifPhysicalLocation{ArtifactLocation: sarifArtifactLocation{URI: stepFile}},
+	}
+
+	if resource.StackName != "" && resource.LogicalID != "" {
+		location.LogicalLocations = []sarifLogicalLocation{
+			{
+				Name:               resource.LogicalID,
+				FullyQualifiedName: fmt.Sprintf("%s/%s", resource.StackName, resource.LogicalID),
+				Kind:               "resource",
+			},
+		}
+	}
+
+	return []sarifLocation{location}
+}
+
+// sarifLevel は検証ルールのseverityをSARIFのlevelに変換する
+func sarifLevel(severity string) string {
+	switch severity {
+	case "error":
+		return "error"
+	case "warning":
+		return "warning"
+	case "info":
+		return "note"
+	default:
+		return "warning"
+	}
+}
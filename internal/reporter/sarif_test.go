@@ -0,0 +1,53 @@
+package reporter
+
+import (
+	"bytes"
+	"encoding/json"
+	"sbcntr2-test-tool/internal/validator"
+	"testing"
+)
+
+// リソースや通信経路に紐づかないエラーだけがstep_errorとして出力されることを確認する
+func TestSARIFReportsErrorsNotTiedToRules(t *testing.T) {
+	result := sampleValidationResult()
+	result.Errors = append(result.Errors, validator.ValidationError{
+		Type:       validator.ErrorResourceNotFound,
+		Resource:   "sbcntr-base",
+		Message:    "CloudFormation stack 'sbcntr-base' not found",
+		Suggestion: "Create the stack",
+	})
+
+	var buf bytes.Buffer
+	if err := NewSARIFReporter(&buf).ReportResult(result); err != nil {
+		t.Fatalf("ReportResult: %v", err)
+	}
+
+	var log sarifLog
+	if err := json.Unmarshal(buf.Bytes(), &log); err != nil {
+		t.Fatalf("output is not valid JSON: %v", err)
+	}
+
+	counts := map[string]int{}
+	for _, r := range log.Runs[0].Results {
+		counts[r.RuleID]++
+		if r.RuleID == sarifRuleStepError {
+			if r.Message.Text != "CloudFormation stack 'sbcntr-base' not found" {
+				t.Errorf("step_error message = %q", r.Message.Text)
+			}
+			if r.Properties["suggestion"] != "Create the stack" {
+				t.Errorf("step_error suggestion = %v", r.Properties["suggestion"])
+			}
+		}
+	}
+
+	want := map[string]int{
+		"vpc_cidr_check":       1,
+		sarifRuleFlowReachable: 1,
+		sarifRuleStepError:     1,
+	}
+	for ruleID, count := range want {
+		if counts[ruleID] != count {
+			t.Errorf("results for %s = %d, want %d (all: %v)", ruleID, counts[ruleID], count, counts)
+		}
+	}
+}
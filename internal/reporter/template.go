@@ -0,0 +1,179 @@
+package reporter
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sbcntr2-test-tool/internal/validator"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// TemplateReporter はユーザーが用意したtext/templateでレポートを出力する
+// テンプレートには TemplateData が渡される
+type TemplateReporter struct {
+	out  io.Writer
+	tmpl *template.Template
+}
+
+// TemplateData はテンプレートに渡すデータ
+// ステップの結果は --output json と同じ構造体（フィールド名はGoの名前。durationMs なら .DurationMs）で渡し、
+// validatorパッケージの内部の型を変更してもユーザーのテンプレートが壊れないようにする
+//
+//	.GeneratedAt  レポートの生成日時
+//	.Summary      全ステップの集計（--all の場合のみ。単一ステップの場合はnil）
+//	.Results      ステップごとの検証結果
+type TemplateData struct {
+	GeneratedAt time.Time
+	Summary     *TemplateSummary
+	Results     []jsonStep
+}
+
+// TemplateSummary は全ステップの集計
+type TemplateSummary struct {
+	TotalSteps   int
+	PassedSteps  int
+	FailedSteps  int
+	SkippedSteps int
+}
+
+// ansiColors はcolor関数で指定できる色
+var ansiColors = map[string]string{
+	"red":    "\033[31m",
+	"green":  "\033[32m",
+	"yellow": "\033[33m",
+	"blue":   "\033[34m",
+	"gray":   "\033[90m",
+	"bold":   "\033[1m",
+}
+
+// NewTemplateReporter はテンプレートを読み込む。colorがfalseの場合、color関数は色をつけずに文字列を返す
+func NewTemplateReporter(out io.Writer, templateFile string, color bool) (*TemplateReporter, error) {
+	if templateFile == "" {
+		return nil, fmt.Errorf("--template-file is required for template output")
+	}
+
+	tmpl, err := template.New(filepath.Base(templateFile)).Funcs(TemplateFuncs(color)).ParseFiles(templateFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse template %s: %w", templateFile, err)
+	}
+
+	return &TemplateReporter{out: out, tmpl: tmpl}, nil
+}
+
+func (r *TemplateReporter) ReportResult(result *validator.ValidationResult) error {
+	return r.render(TemplateData{
+		GeneratedAt: time.Now(),
+		Results:     []jsonStep{(&JSONReporter{}).formatResult(result)},
+	})
+}
+
+func (r *TemplateReporter) ReportSummary(summary *validator.ValidationSummary) error {
+	report := (&JSONReporter{}).formatSummary(summary)
+	return r.render(TemplateData{
+		GeneratedAt: time.Now(),
+		Summary: &TemplateSummary{
+			TotalSteps:   report.TotalSteps,
+			PassedSteps:  report.PassedSteps,
+			FailedSteps:  report.FailedSteps,
+			SkippedSteps: report.SkippedSteps,
+		},
+		Results: report.Results,
+	})
+}
+
+func (r *TemplateReporter) render(data TemplateData) error {
+	if err := r.tmpl.Execute(r.out, data); err != nil {
+		return fmt.Errorf("failed to render template: %w", err)
+	}
+	return nil
+}
+
+// TemplateFuncs はテンプレートで使えるヘルパー関数
+//
+//	statusIcon    ステップ・リソースの状態、検証ルール・通信経路の結果をアイコンにする
+//	statusText    ステップ・リソースの状態を文字列にする（"PASSED", "NOT_FOUND" 等）
+//	color         color "red" "text" でANSIカラーをつける（red, green, yellow, blue, gray, bold）
+//	              colorがfalse（出力先が端末でない、NO_COLOR・--no-color の指定等）の場合は色をつけない
+//	json          値をJSONにする
+//	indent        indent 4 "text" で各行をインデントする
+//	duration      ミリ秒の所要時間（.DurationMs）を "2.34s" のように表示する
+//	value         期待値・実際の値を表示用の文字列にする（mapやスライスはJSON）
+//	upper, lower, join, repeat, contains  stringsパッケージの同名関数
+func TemplateFuncs(color bool) template.FuncMap {
+	return template.FuncMap{
+		"statusIcon": templateStatusIcon,
+		"statusText": func(status interface{}) string {
+			return fmt.Sprint(status)
+		},
+		"color": func(name, text string) string {
+			code, ok := ansiColors[name]
+			if !ok || !color {
+				return text
+			}
+			return code + text + "\033[0m"
+		},
+		"json": func(value interface{}) (string, error) {
+			data, err := json.MarshalIndent(value, "", "  ")
+			if err != nil {
+				return "", err
+			}
+			return string(data), nil
+		},
+		"indent": func(spaces int, text string) string {
+			return indentLines(text, spaces)
+		},
+		"duration": func(ms int64) string {
+			return (time.Duration(ms) * time.Millisecond).String()
+		},
+		"value":    formatValue,
+		"upper":    strings.ToUpper,
+		"lower":    strings.ToLower,
+		"join":     strings.Join,
+		"repeat":   strings.Repeat,
+		"contains": strings.Contains,
+	}
+}
+
+func templateStatusIcon(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		switch v {
+		case validator.StatusPassed.String(), validator.ResourceExists.String():
+			return "✅"
+		case validator.StatusFailed.String(), validator.ResourceNotFound.String():
+			return "❌"
+		case validator.StatusWarning.String(), validator.ResourceMisconfigured.String():
+			return "⚠️"
+		case validator.StatusSkipped.String():
+			return "⏭️"
+		default:
+			return "⏸️"
+		}
+	case jsonRule:
+		if v.Passed {
+			return "✅"
+		}
+		if v.Severity == "error" {
+			return "❌"
+		}
+		return "⚠️"
+	case jsonFlow:
+		if v.Reachable {
+			return "✅"
+		}
+		if v.Indeterminate {
+			return "⚠️"
+		}
+		return "❌"
+	case bool:
+		if v {
+			return "✅"
+		}
+		return "❌"
+	default:
+		return "?"
+	}
+}
@@ -0,0 +1,206 @@
+package reporter
+
+import (
+	"io"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ConsoleOptions はコンソール出力の表示方法
+type ConsoleOptions struct {
+	// 1以上で検証ルールごとの結果と取得したプロパティ、2以上でAWS APIのレスポンスも表示する
+	Verbosity int
+	// ANSIカラーで色をつける
+	Color bool
+	// 絵文字と罫線を使わずASCII文字だけで表示する
+	ASCII bool
+	// リソースごとに1行で表示する
+	Compact bool
+	// 折り返す幅。0の場合は折り返さない
+	Width int
+}
+
+// DetectConsoleOptions は出力先の端末に合わせた表示方法を返す
+//   - 出力先が端末で、NO_COLORが設定されておらず、TERMがdumbでなければ色をつける
+//     Windowsではコンソールの仮想ターミナル処理を有効にし、有効にできない従来のコンソールでは色をつけない
+//   - TERMがdumbの場合や、Windowsの従来のコンソールではASCII文字だけで表示する
+//   - 出力先が端末の場合は端末の幅（COLUMNSが設定されていればその値）で折り返す
+func DetectConsoleOptions(out io.Writer) ConsoleOptions {
+	file, _ := out.(*os.File)
+	tty := file != nil && isTerminal(file)
+	dumb := os.Getenv("TERM") == "dumb"
+
+	options := ConsoleOptions{
+		Color: tty && !dumb && os.Getenv("NO_COLOR") == "" && enableANSI(file),
+		ASCII: dumb || (runtime.GOOS == "windows" && os.Getenv("WT_SESSION") == ""),
+	}
+
+	if columns, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && columns > 0 {
+		options.Width = columns
+	} else if tty {
+		options.Width = terminalWidth(file)
+	}
+
+	return options
+}
+
+func isTerminal(file *os.File) bool {
+	info, err := file.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+const (
+	ansiReset  = "\033[0m"
+	ansiBold   = "\033[1m"
+	ansiRed    = "\033[31m"
+	ansiGreen  = "\033[32m"
+	ansiYellow = "\033[33m"
+	ansiGray   = "\033[90m"
+)
+
+// consoleSymbols はコンソール出力で使う記号
+type consoleSymbols struct {
+	passed, failed, warning, skipped, pending string
+	check, cross                              string
+	bullet, suggestion, reference             string
+	drift, flow, celebrate                    string
+	heavyLine, lightLine                      string
+	boxTop, boxSide, boxBottom                string
+}
+
+var emojiSymbols = consoleSymbols{
+	passed: "✅", failed: "❌", warning: "⚠️ ", skipped: "⏭️ ", pending: "⏸️ ",
+	check: "✓", cross: "✗",
+	bullet: "•", suggestion: "💡", reference: "📖",
+	drift: "🔀", flow: "🔌", celebrate: "🎉",
+	heavyLine: "=", lightLine: "-",
+	boxTop: "╔═╗", boxSide: "║", boxBottom: "╚═╝",
+}
+
+var asciiSymbols = consoleSymbols{
+	passed: "[OK]", failed: "[NG]", warning: "[!!]", skipped: "[--]", pending: "[..]",
+	check: "+", cross: "x",
+	bullet: "*", suggestion: ">", reference: ">",
+	drift: "[drift]", flow: "[flow]", celebrate: "***",
+	heavyLine: "=", lightLine: "-",
+	boxTop: "+-+", boxSide: "|", boxBottom: "+-+",
+}
+
+// displayWidth は端末に表示したときの幅を返す。全角文字と絵文字は2、結合文字と異体字セレクタは0として数える
+func displayWidth(s string) int {
+	width := 0
+	for _, r := range s {
+		switch {
+		case r == '\uFE0F' || unicode.Is(unicode.Mn, r):
+		case isWide(r):
+			width += 2
+		default:
+			width++
+		}
+	}
+	return width
+}
+
+func isWide(r rune) bool {
+	return (r >= 0x1100 && r <= 0x115F) ||
+		(r >= 0x2E80 && r <= 0xA4CF) ||
+		(r >= 0xAC00 && r <= 0xD7A3) ||
+		(r >= 0xF900 && r <= 0xFAFF) ||
+		(r >= 0xFE30 && r <= 0xFE4F) ||
+		(r >= 0xFF00 && r <= 0xFF60) ||
+		(r >= 0xFFE0 && r <= 0xFFE6) ||
+		(r >= 0x1F300 && r <= 0x1FAFF) ||
+		r == 0x2705 || r == 0x274C || r == 0x23F8 || r == 0x23ED
+}
+
+// padRight は表示幅がwidthになるまで空白で埋める
+func padRight(s string, width int) string {
+	if w := displayWidth(s); w < width {
+		return s + strings.Repeat(" ", width-w)
+	}
+	return s
+}
+
+// wrapText はtextを表示幅widthで折り返した行を返す
+// 空白で区切れない長い語（日本語の文など）は文字単位で折り返す
+func wrapText(text string, width int) []string {
+	if width <= 0 || displayWidth(text) <= width {
+		return []string{text}
+	}
+
+	var lines []string
+	var line strings.Builder
+	lineWidth := 0
+
+	flush := func() {
+		lines = append(lines, strings.TrimRight(line.String(), " "))
+		line.Reset()
+		lineWidth = 0
+	}
+
+	for _, word := range strings.Fields(text) {
+		wordWidth := displayWidth(word)
+
+		if lineWidth > 0 && lineWidth+1+wordWidth > width {
+			flush()
+		}
+		if lineWidth > 0 {
+			line.WriteByte(' ')
+			lineWidth++
+		}
+
+		// 1行に収まらない語は入る分ずつ書き出す
+		for lineWidth+wordWidth > width {
+			head, rest := splitAtWidth(word, width-lineWidth)
+			if head == "" {
+				_, size := utf8.DecodeRuneInString(word)
+				head, rest = word[:size], word[size:]
+			}
+			line.WriteString(head)
+			flush()
+			word = rest
+			wordWidth = displayWidth(word)
+		}
+
+		line.WriteString(word)
+		lineWidth += wordWidth
+	}
+	if lineWidth > 0 {
+		flush()
+	}
+
+	return lines
+}
+
+// splitAtWidth はsを表示幅width以内の先頭部分と残りに分ける
+func splitAtWidth(s string, width int) (string, string) {
+	w := 0
+	for i, r := range s {
+		rw := displayWidth(string(r))
+		if w+rw > width {
+			return s[:i], s[i:]
+		}
+		w += rw
+	}
+	return s, ""
+}
+
+// truncate はsを表示幅widthに収まるように切り詰める
+func truncate(s string, width int) string {
+	if width <= 0 || displayWidth(s) <= width {
+		return s
+	}
+	if width <= 3 {
+		head, _ := splitAtWidth(s, width)
+		return head
+	}
+	head, _ := splitAtWidth(s, width-3)
+	return head + "..."
+}
@@ -0,0 +1,13 @@
+//go:build !unix && !windows
+
+package reporter
+
+import "os"
+
+func terminalWidth(file *os.File) int {
+	return 0
+}
+
+func enableANSI(file *os.File) bool {
+	return true
+}
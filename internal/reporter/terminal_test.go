@@ -0,0 +1,137 @@
+package reporter
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDisplayWidth(t *testing.T) {
+	tests := []struct {
+		s    string
+		want int
+	}{
+		{"", 0},
+		{"abc", 3},
+		{"日本語", 6},
+		{"aあ", 3},
+		{"ＡＢ", 4},
+		{"✅", 2},
+		{"🎉", 2},
+		// 異体字セレクタ（U+FE0F）は幅に数えない
+		{"⏭\uFE0F", 2},
+		{"⚠\uFE0F", 1},
+		// 結合文字は幅に数えない
+		{"e\u0301", 1},
+	}
+
+	for _, tt := range tests {
+		if got := displayWidth(tt.s); got != tt.want {
+			t.Errorf("displayWidth(%q) = %d, want %d", tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestIsWide(t *testing.T) {
+	tests := []struct {
+		r    rune
+		want bool
+	}{
+		{'a', false},
+		{'あ', true},
+		{'漢', true},
+		{'한', true},
+		{'Ａ', true},
+		{'ｱ', false},
+		{'🔌', true},
+		{'✅', true},
+		{'❌', true},
+		{'⚠', false},
+		{'\uFE0F', false},
+	}
+
+	for _, tt := range tests {
+		if got := isWide(tt.r); got != tt.want {
+			t.Errorf("isWide(%q) = %v, want %v", tt.r, got, tt.want)
+		}
+	}
+}
+
+func TestWrapText(t *testing.T) {
+	tests := []struct {
+		text  string
+		width int
+		want  []string
+	}{
+		{"hello world", 0, []string{"hello world"}},
+		{"hello world", 20, []string{"hello world"}},
+		{"hello world foo", 11, []string{"hello world", "foo"}},
+		{"a  b", 2, []string{"a", "b"}},
+		// 幅より長い語は文字単位で折り返す
+		{"abcdefghij", 4, []string{"abcd", "efgh", "ij"}},
+		{"ab cdefgh", 4, []string{"ab", "cdef", "gh"}},
+		{"日本語のテキスト", 6, []string{"日本語", "のテキ", "スト"}},
+		// 全角文字は途中で分けない
+		{"日本語", 5, []string{"日本", "語"}},
+		{"a 日本語", 4, []string{"a", "日本", "語"}},
+		// 1文字も入らない幅でも1文字ずつ進める
+		{"日本", 1, []string{"日", "本"}},
+	}
+
+	for _, tt := range tests {
+		if got := wrapText(tt.text, tt.width); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("wrapText(%q, %d) = %q, want %q", tt.text, tt.width, got, tt.want)
+		}
+	}
+}
+
+func TestSplitAtWidth(t *testing.T) {
+	tests := []struct {
+		s        string
+		width    int
+		wantHead string
+		wantRest string
+	}{
+		{"", 3, "", ""},
+		{"abc", 0, "", "abc"},
+		{"abc", 2, "ab", "c"},
+		{"abc", 5, "abc", ""},
+		{"日本語", 3, "日", "本語"},
+		{"日本語", 1, "", "日本語"},
+		{"✅ok", 2, "✅", "ok"},
+		// 異体字セレクタは直前の文字と分けない
+		{"⚠\uFE0Fa", 1, "⚠\uFE0F", "a"},
+	}
+
+	for _, tt := range tests {
+		head, rest := splitAtWidth(tt.s, tt.width)
+		if head != tt.wantHead || rest != tt.wantRest {
+			t.Errorf("splitAtWidth(%q, %d) = (%q, %q), want (%q, %q)", tt.s, tt.width, head, rest, tt.wantHead, tt.wantRest)
+		}
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		s     string
+		width int
+		want  string
+	}{
+		{"hello", 0, "hello"},
+		{"hello", -1, "hello"},
+		{"hello", 5, "hello"},
+		{"hello world", 8, "hello..."},
+		{"日本語テキスト", 7, "日本..."},
+		{"✅✅✅", 5, "✅..."},
+		// 幅が3以下の場合は "..." を付けない
+		{"hello", 3, "hel"},
+		{"hello", 1, "h"},
+		{"日本語", 3, "日"},
+		{"日本語", 1, ""},
+	}
+
+	for _, tt := range tests {
+		if got := truncate(tt.s, tt.width); got != tt.want {
+			t.Errorf("truncate(%q, %d) = %q, want %q", tt.s, tt.width, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,182 @@
+package validator
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	"sbcntr2-test-tool/internal/aws"
+	"sbcntr2-test-tool/internal/i18n"
+
+	cctypes "github.com/aws/aws-sdk-go-v2/service/cloudcontrol/types"
+)
+
+// cloudControlLookupLimit はListResourcesの結果に名前やタグが含まれない場合に
+// GetResourceで詳細を取得するリソース数の上限。上限に達しても見つからない場合はエラーにする
+const cloudControlLookupLimit = 50
+
+// checkCloudControlResource は専用のチェッカーがないリソースタイプをCloud Control APIで検索する
+// identifierが指定されていればそれをプライマリ識別子として取得し、
+// そうでなければ名前をプライマリ識別子として試した後、ListResourcesの結果から
+// 名前を表すプロパティまたはNameタグが一致するリソースを探す
+func (v *ResourceValidator) checkCloudControlResource(ctx context.Context, resourceType, resourceName, identifier string) (bool, map[string]interface{}, error) {
+	if identifier != "" {
+		resource, err := v.awsClient.GetResource(ctx, resourceType, identifier)
+		if isCloudControlNotFound(err) {
+			return false, nil, nil
+		}
+		if err != nil {
+			return false, nil, err
+		}
+		return true, normalizeCloudControlProperties(resource), nil
+	}
+
+	// プライマリ識別子が名前のタイプ（AWS::ECS::Cluster等）はこれで見つかる
+	// 名前が識別子の形式でない場合もエラーになるため、失敗してもListResourcesで探す
+	if resource, err := v.awsClient.GetResource(ctx, resourceType, resourceName); err == nil {
+		return true, normalizeCloudControlProperties(resource), nil
+	}
+
+	nameProperties := []string{"Name"}
+	tagProperty := "Tags"
+	if schema, err := v.awsClient.GetResourceSchema(ctx, resourceType); err == nil {
+		nameProperties = schema.NameProperties()
+		tagProperty = schema.TagProperty
+	}
+
+	resources, err := v.awsClient.ListResources(ctx, resourceType)
+	if err != nil {
+		return false, nil, err
+	}
+
+	lookups := 0
+	limited := false
+	for _, resource := range resources {
+		// ListResourcesは識別子だけを返すタイプが多いため、必要に応じて詳細を取得する
+		if !hasAnyProperty(resource.Properties, nameProperties, tagProperty) {
+			if lookups >= cloudControlLookupLimit {
+				limited = true
+			} else {
+				lookups++
+				detail, err := v.awsClient.GetResource(ctx, resourceType, resource.Identifier)
+				if isCloudControlNotFound(err) {
+					// 一覧の取得後に削除されたリソース
+					continue
+				}
+				if err != nil {
+					return false, nil, err
+				}
+				resource = detail
+			}
+		}
+
+		if matchesCloudControlName(resource, resourceName, nameProperties, tagProperty) {
+			return true, normalizeCloudControlProperties(resource), nil
+		}
+	}
+
+	if limited {
+		return false, nil, i18n.Errorf("cloudcontrol.lookup_limit", resourceType, resourceName, cloudControlLookupLimit)
+	}
+
+	return false, nil, nil
+}
+
+// isCloudControlNotFound はエラーがリソースが存在しないことを示すかを判定する
+func isCloudControlNotFound(err error) bool {
+	var notFound *cctypes.ResourceNotFoundException
+	return errors.As(err, &notFound)
+}
+
+func hasAnyProperty(props map[string]interface{}, nameProperties []string, tagProperty string) bool {
+	for _, name := range nameProperties {
+		if _, ok := props[name]; ok {
+			return true
+		}
+	}
+	if tagProperty != "" {
+		if _, ok := props[tagProperty]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesCloudControlName は識別子、名前を表すプロパティ、Nameタグのいずれかが名前に一致するかを判定する
+func matchesCloudControlName(resource *aws.CloudControlResource, name string, nameProperties []string, tagProperty string) bool {
+	if resource.Identifier == name {
+		return true
+	}
+
+	for _, property := range nameProperties {
+		if value, ok := resource.Properties[property].(string); ok && value == name {
+			return true
+		}
+	}
+
+	return tagProperty != "" && cloudControlNameTag(resource.Properties[tagProperty]) == name
+}
+
+// cloudControlNameTag はタグからNameタグの値を取り出す
+// タグは [{"Key": "Name", "Value": "..."}] 形式と {"Name": "..."} 形式の両方がある
+func cloudControlNameTag(tags interface{}) string {
+	switch t := tags.(type) {
+	case []interface{}:
+		for _, item := range t {
+			tag, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if key, _ := tag["Key"].(string); key == "Name" {
+				value, _ := tag["Value"].(string)
+				return value
+			}
+		}
+	case map[string]interface{}:
+		value, _ := t["Name"].(string)
+		return value
+	}
+	return ""
+}
+
+// normalizeCloudControlProperties はCloud Controlのプロパティを専用のチェッカーと同じ形式にそろえる
+// JSONの数値（float64）のうち整数値はintに変換し、Identifierと（Nameプロパティがなければ）Nameタグの値を追加する
+func normalizeCloudControlProperties(resource *aws.CloudControlResource) map[string]interface{} {
+	props, ok := normalizeJSONValue(resource.Properties).(map[string]interface{})
+	if !ok || props == nil {
+		props = map[string]interface{}{}
+	}
+
+	props["Identifier"] = resource.Identifier
+	if _, exists := props["Name"]; !exists {
+		if name := cloudControlNameTag(props["Tags"]); name != "" {
+			props["Name"] = name
+		}
+	}
+
+	return props
+}
+
+func normalizeJSONValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		normalized := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			normalized[key] = normalizeJSONValue(item)
+		}
+		return normalized
+	case []interface{}:
+		normalized := make([]interface{}, len(v))
+		for i, item := range v {
+			normalized[i] = normalizeJSONValue(item)
+		}
+		return normalized
+	case float64:
+		if v == math.Trunc(v) && math.Abs(v) < math.MaxInt32 {
+			return int(v)
+		}
+		return v
+	default:
+		return v
+	}
+}
@@ -0,0 +1,134 @@
+package validator
+
+import (
+	"reflect"
+	"testing"
+
+	"sbcntr2-test-tool/internal/aws"
+)
+
+func TestCloudControlNameTag(t *testing.T) {
+	tests := []struct {
+		name string
+		tags interface{}
+		want string
+	}{
+		{
+			name: "key value list",
+			tags: []interface{}{
+				map[string]interface{}{"Key": "Env", "Value": "dev"},
+				map[string]interface{}{"Key": "Name", "Value": "sbcntr-alerts"},
+			},
+			want: "sbcntr-alerts",
+		},
+		{
+			name: "map",
+			tags: map[string]interface{}{"Name": "sbcntr-alerts"},
+			want: "sbcntr-alerts",
+		},
+		{
+			name: "no name tag",
+			tags: []interface{}{map[string]interface{}{"Key": "Env", "Value": "dev"}},
+			want: "",
+		},
+		{
+			name: "no tags",
+			tags: nil,
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cloudControlNameTag(tt.tags); got != tt.want {
+				t.Errorf("cloudControlNameTag() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchesCloudControlName(t *testing.T) {
+	nameProperties := []string{"Name", "TopicName"}
+
+	tests := []struct {
+		name        string
+		resource    *aws.CloudControlResource
+		tagProperty string
+		want        bool
+	}{
+		{
+			name:     "identifier",
+			resource: &aws.CloudControlResource{Identifier: "sbcntr-alerts"},
+			want:     true,
+		},
+		{
+			name: "name property",
+			resource: &aws.CloudControlResource{
+				Identifier: "arn:aws:sns:ap-northeast-1:123456789012:sbcntr-alerts",
+				Properties: map[string]interface{}{"TopicName": "sbcntr-alerts"},
+			},
+			want: true,
+		},
+		{
+			name: "name tag",
+			resource: &aws.CloudControlResource{
+				Identifier: "id-1",
+				Properties: map[string]interface{}{"Tags": []interface{}{map[string]interface{}{"Key": "Name", "Value": "sbcntr-alerts"}}},
+			},
+			tagProperty: "Tags",
+			want:        true,
+		},
+		{
+			name: "name tag without tag property",
+			resource: &aws.CloudControlResource{
+				Identifier: "id-1",
+				Properties: map[string]interface{}{"Tags": []interface{}{map[string]interface{}{"Key": "Name", "Value": "sbcntr-alerts"}}},
+			},
+			want: false,
+		},
+		{
+			name: "other name",
+			resource: &aws.CloudControlResource{
+				Identifier: "id-1",
+				Properties: map[string]interface{}{"Name": "sbcntr-other", "DisplayName": "sbcntr-alerts"},
+			},
+			tagProperty: "Tags",
+			want:        false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := matchesCloudControlName(tt.resource, "sbcntr-alerts", nameProperties, tt.tagProperty); got != tt.want {
+				t.Errorf("matchesCloudControlName() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeJSONValue(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+		want  interface{}
+	}{
+		{"integer", float64(443), 443},
+		{"negative integer", float64(-1), -1},
+		{"fraction", 1.5, 1.5},
+		{"too large for int32", float64(1 << 40), float64(1 << 40)},
+		{"string", "sbcntr", "sbcntr"},
+		{
+			name:  "nested",
+			value: map[string]interface{}{"Ports": []interface{}{float64(80), map[string]interface{}{"Port": float64(8080)}}},
+			want:  map[string]interface{}{"Ports": []interface{}{80, map[string]interface{}{"Port": 8080}}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := normalizeJSONValue(tt.value); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("normalizeJSONValue() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,9 +2,12 @@ package validator
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sbcntr2-test-tool/internal/aws"
 	"sbcntr2-test-tool/internal/config"
+	"sbcntr2-test-tool/internal/i18n"
+	"strings"
 	"time"
 )
 
@@ -12,6 +15,11 @@ type Engine struct {
 	awsClient     *aws.Client
 	configManager *config.Manager
 	cache         map[string]interface{}
+	detectDrift   bool
+	driftTimeout  time.Duration
+	recordAPI     bool
+	observers     []Observer
+	run           RunInfo
 }
 
 func NewEngine(awsClient *aws.Client, configManager *config.Manager) *Engine {
@@ -22,13 +30,40 @@ func NewEngine(awsClient *aws.Client, configManager *config.Manager) *Engine {
 	}
 }
 
+// EnableDriftDetection はCloudFormationスタックのドリフト検出を有効にする
+func (e *Engine) EnableDriftDetection(timeout time.Duration) {
+	e.detectDrift = true
+	e.driftTimeout = timeout
+}
+
+// EnableAPICallRecording はリソースごとにAWS APIのレスポンスを記録する（-vv で表示）
+func (e *Engine) EnableAPICallRecording() {
+	e.recordAPI = true
+}
+
 func (e *Engine) ValidateStep(stepNumber int) (*ValidationResult, error) {
+	e.startRun([]int{stepNumber})
+
+	summary := &ValidationSummary{TotalSteps: 1, Results: []ValidationResult{}}
+	result, err := e.validateStep(stepNumber)
+	if err != nil {
+		summary.SkippedSteps++
+	} else {
+		summary.addResult(result)
+	}
+	e.finishRun(summary)
+
+	return result, err
+}
+
+func (e *Engine) validateStep(stepNumber int) (*ValidationResult, error) {
 	startTime := time.Now()
 
 	stepConfig, err := e.configManager.LoadStepConfig(stepNumber)
 	if err != nil {
 		return nil, fmt.Errorf("failed to load step config: %w", err)
 	}
+	e.notify(func(o Observer) { o.StepStarted(e.run, stepNumber, stepConfig.Name) })
 
 	result := &ValidationResult{
 		StepNumber: stepNumber,
@@ -37,39 +72,68 @@ func (e *Engine) ValidateStep(stepNumber int) (*ValidationResult, error) {
 		Resources:  []ResourceResult{},
 		Errors:     []ValidationError{},
 		Warnings:   []ValidationWarning{},
+		Drifts:     []StackDrift{},
+		Flows:      []FlowResult{},
 	}
 
 	ctx := context.Background()
 
+	var existingStacks []string
 	for _, cfStack := range stepConfig.CloudFormationStacks {
 		if !e.awsClient.StackExists(ctx, cfStack) {
 			result.Errors = append(result.Errors, ValidationError{
 				Type:        ErrorResourceNotFound,
 				Resource:    cfStack,
-				Message:     fmt.Sprintf("CloudFormation stack '%s' not found", cfStack),
-				Suggestion:  fmt.Sprintf("Please create the stack '%s' as described in the handbook", cfStack),
-				DocumentRef: fmt.Sprintf("Step %d", stepNumber),
+				Message:     i18n.T("engine.stack_not_found", cfStack),
+				Suggestion:  i18n.T("engine.stack_not_found.suggestion", cfStack),
+				DocumentRef: i18n.T("engine.step_ref", stepNumber),
 			})
+			continue
+		}
+		existingStacks = append(existingStacks, cfStack)
+
+		if e.detectDrift {
+			e.validateStackDrift(ctx, cfStack, result)
 		}
 	}
 
 	for _, resource := range stepConfig.Resources {
-		resResult := e.validateResource(ctx, resource)
+		resourceStart := time.Now()
+		resourceCtx := ctx
+		var recorder *aws.APICallRecorder
+		if e.recordAPI {
+			resourceCtx, recorder = aws.WithAPICallRecorder(ctx)
+		}
+		resResult := e.validateResource(resourceCtx, stepNumber, resource)
+		resResult.Duration = time.Since(resourceStart)
+		if recorder != nil {
+			resResult.APICalls = recorder.Calls()
+		}
 		result.Resources = append(result.Resources, resResult)
+		e.notify(func(o Observer) { o.ResourceChecked(e.run, stepNumber, &resResult) })
 
 		if resResult.Status == ResourceNotFound && resource.Required {
 			result.Errors = append(result.Errors, ValidationError{
 				Type:        ErrorResourceNotFound,
 				Resource:    resource.Name,
-				Message:     fmt.Sprintf("Required resource '%s' not found", resource.Name),
-				Suggestion:  fmt.Sprintf("Please create the resource '%s' as described in step %d", resource.Name, stepNumber),
-				DocumentRef: fmt.Sprintf("Step %d", stepNumber),
+				Message:     i18n.T("engine.resource_not_found", resource.Name),
+				Suggestion:  i18n.T("engine.resource_not_found.suggestion", resource.Name, stepNumber),
+				DocumentRef: i18n.T("engine.step_ref", stepNumber),
 			})
 		}
 	}
 
+	if len(existingStacks) > 0 {
+		e.linkStackResources(ctx, existingStacks, result)
+	}
+
+	if len(stepConfig.Flows) > 0 {
+		e.validateFlows(ctx, stepConfig.Flows, stepNumber, result)
+	}
+
 	result.Status = e.determineStatus(result)
 	result.Duration = time.Since(startTime)
+	e.notify(func(o Observer) { o.StepFinished(e.run, result) })
 
 	return result, nil
 }
@@ -83,45 +147,110 @@ func (e *Engine) ValidateAllSteps() (*ValidationSummary, error) {
 		Results:      []ValidationResult{},
 	}
 
+	e.startRun([]int{1, 2, 3, 4, 5, 6, 7})
+
 	for i := 1; i <= 7; i++ {
-		result, err := e.ValidateStep(i)
+		result, err := e.validateStep(i)
 		if err != nil {
 			summary.SkippedSteps++
 			continue
 		}
 
-		summary.Results = append(summary.Results, *result)
+		summary.addResult(result)
+	}
 
-		switch result.Status {
-		case StatusPassed:
-			summary.PassedSteps++
-		case StatusFailed:
-			summary.FailedSteps++
-		case StatusSkipped:
-			summary.SkippedSteps++
+	e.finishRun(summary)
+
+	return summary, nil
+}
+
+func (s *ValidationSummary) addResult(result *ValidationResult) {
+	s.Results = append(s.Results, *result)
+
+	switch result.Status {
+	case StatusPassed:
+		s.PassedSteps++
+	case StatusFailed:
+		s.FailedSteps++
+	case StatusSkipped:
+		s.SkippedSteps++
+	}
+}
+
+// validateStackDrift はスタックのドリフトを検出し、結果と警告をValidationResultに追加する
+func (e *Engine) validateStackDrift(ctx context.Context, stackName string, result *ValidationResult) {
+	stackDrift, err := e.awsClient.DetectCloudFormationStackDrift(ctx, stackName, e.driftTimeout)
+	if errors.Is(err, aws.ErrDriftDetectionTimeout) {
+		result.Warnings = append(result.Warnings, ValidationWarning{
+			Resource: stackName,
+			Message:  i18n.T("engine.drift_timeout", e.driftTimeout),
+		})
+		return
+	}
+	if err != nil {
+		result.Warnings = append(result.Warnings, ValidationWarning{
+			Resource: stackName,
+			Message:  i18n.T("engine.drift_failed", err),
+		})
+		return
+	}
+
+	drift := StackDrift{
+		StackName:       stackDrift.StackName,
+		DetectionStatus: stackDrift.DetectionStatus,
+		DriftStatus:     stackDrift.DriftStatus,
+		Resources:       []ResourceDrift{},
+	}
+
+	for _, res := range stackDrift.Resources {
+		resourceDrift := ResourceDrift{
+			LogicalID:           res.LogicalID,
+			PhysicalID:          res.PhysicalID,
+			Type:                res.Type,
+			DriftStatus:         res.DriftStatus,
+			PropertyDifferences: []PropertyDifference{},
+		}
+		for _, diff := range res.PropertyDifferences {
+			resourceDrift.PropertyDifferences = append(resourceDrift.PropertyDifferences, PropertyDifference{
+				PropertyPath:   diff.PropertyPath,
+				ExpectedValue:  diff.ExpectedValue,
+				ActualValue:    diff.ActualValue,
+				DifferenceType: diff.DifferenceType,
+			})
+		}
+		drift.Resources = append(drift.Resources, resourceDrift)
+
+		// コンソールでの手動変更や削除は警告として通知する
+		if res.DriftStatus == "MODIFIED" || res.DriftStatus == "DELETED" {
+			result.Warnings = append(result.Warnings, ValidationWarning{
+				Resource: fmt.Sprintf("%s/%s", stackName, res.LogicalID),
+				Message:  i18n.T("engine.drifted", res.DriftStatus),
+			})
 		}
 	}
 
-	return summary, nil
+	result.Drifts = append(result.Drifts, drift)
 }
 
-func (e *Engine) validateResource(ctx context.Context, resource config.ResourceDefinition) ResourceResult {
+func (e *Engine) validateResource(ctx context.Context, stepNumber int, resource config.ResourceDefinition) ResourceResult {
 	result := ResourceResult{
 		Type:     resource.Type,
 		ID:       resource.Identifier,
 		Name:     resource.Name,
+		Required: resource.Required,
 		Status:   ResourceNotFound,
 		Expected: make(map[string]interface{}),
 		Actual:   make(map[string]interface{}),
 		Errors:   []string{},
 		Warnings: []string{},
+		Rules:    []RuleResult{},
 	}
 
 	validator := NewResourceValidator(e.awsClient, e.configManager)
 
-	exists, actualProps, err := validator.CheckResourceExists(ctx, resource.Type, resource.Name)
+	exists, actualProps, err := validator.CheckResourceExists(ctx, resource)
 	if err != nil {
-		result.Errors = append(result.Errors, fmt.Sprintf("Failed to check resource: %v", err))
+		result.Errors = append(result.Errors, i18n.T("engine.check_failed", err))
 		return result
 	}
 
@@ -143,7 +272,24 @@ func (e *Engine) validateResource(ctx context.Context, resource config.ResourceD
 
 				result.Expected[rule.Property] = rule.Expected
 
+				actualValue, _ := validator.getNestedProperty(actualProps, rule.Property)
+				ruleResult := RuleResult{
+					Name:         rule.Name,
+					Type:         rule.Type,
+					Property:     rule.Property,
+					Operator:     rule.Operator,
+					Expected:     rule.Expected,
+					Actual:       actualValue,
+					Severity:     rule.Severity,
+					Passed:       true,
+					ErrorMessage: rule.ErrorMessage.String(),
+					Suggestion:   rule.Suggestion.String(),
+					DocumentRef:  rule.DocumentRef,
+				}
+
 				if err := validator.ValidateRule(actualProps, rule); err != nil {
+					ruleResult.Passed = false
+					ruleResult.Message = err.Error()
 					if rule.Severity == "error" {
 						result.Status = ResourceMisconfigured
 						result.Errors = append(result.Errors, err.Error())
@@ -151,6 +297,9 @@ func (e *Engine) validateResource(ctx context.Context, resource config.ResourceD
 						result.Warnings = append(result.Warnings, err.Error())
 					}
 				}
+
+				result.Rules = append(result.Rules, ruleResult)
+				e.notify(func(o Observer) { o.RuleEvaluated(e.run, stepNumber, &result, ruleResult) })
 			}
 		}
 	}
@@ -158,6 +307,92 @@ func (e *Engine) validateResource(ctx context.Context, resource config.ResourceD
 	return result
 }
 
+// linkStackResources はCloudFormationスタックで作成されたリソースにスタック名と論理IDを設定する
+// スタックリソースの物理IDを、リソース名や取得したプロパティの識別子（〜Id, 〜Arn, 〜Name）と照合する
+func (e *Engine) linkStackResources(ctx context.Context, stacks []string, result *ValidationResult) {
+	for _, stackName := range stacks {
+		stackResources, err := e.awsClient.ListCloudFormationResources(ctx, stackName)
+		if err != nil {
+			continue
+		}
+
+		for i := range result.Resources {
+			resource := &result.Resources[i]
+			if resource.StackName != "" {
+				continue
+			}
+			for _, stackResource := range stackResources {
+				if stackResource.Type == resource.Type && matchesPhysicalID(resource, stackResource.PhysicalID) {
+					resource.StackName = stackName
+					resource.LogicalID = stackResource.LogicalID
+					break
+				}
+			}
+		}
+	}
+}
+
+func matchesPhysicalID(resource *ResourceResult, physicalID string) bool {
+	if physicalID == "" {
+		return false
+	}
+	if resource.Name == physicalID || resource.ID == physicalID {
+		return true
+	}
+	for key, value := range resource.Actual {
+		str, ok := value.(string)
+		if !ok || str != physicalID {
+			continue
+		}
+		if key == "Identifier" || strings.HasSuffix(key, "Id") || strings.HasSuffix(key, "Arn") || strings.HasSuffix(key, "Name") {
+			return true
+		}
+	}
+	return false
+}
+
+// validateFlows はstep YAMLのflowsに宣言した通信経路の到達性を評価する
+func (e *Engine) validateFlows(ctx context.Context, flows []config.FlowDefinition, stepNumber int, result *ValidationResult) {
+	analyzer := NewReachabilityAnalyzer(NewResourceValidator(e.awsClient, e.configManager))
+
+	for _, flow := range flows {
+		flowResult := analyzer.Evaluate(ctx, flow)
+		result.Flows = append(result.Flows, flowResult)
+
+		if flowResult.Reachable {
+			continue
+		}
+
+		flowName := fmt.Sprintf("%s -> %s (%s/%d)", flowResult.From, flowResult.To, flowResult.Protocol, flowResult.Port)
+		if flowResult.Indeterminate {
+			result.Warnings = append(result.Warnings, ValidationWarning{
+				Resource: flowName,
+				Message:  i18n.T("engine.flow_indeterminate", flowName, flowResult.Reason),
+			})
+			continue
+		}
+		if flowResult.BlockingHop == "" {
+			result.Errors = append(result.Errors, ValidationError{
+				Type:        ErrorResourceNotFound,
+				Resource:    flowName,
+				Message:     i18n.T("engine.flow_failed", flowName, flowResult.Reason),
+				Suggestion:  i18n.T("engine.flow_failed.suggestion"),
+				DocumentRef: i18n.T("engine.step_ref", stepNumber),
+			})
+			continue
+		}
+
+		result.Errors = append(result.Errors, ValidationError{
+			Type:        ErrorNetworkFailure,
+			Resource:    flowName,
+			Property:    flowResult.BlockingHop,
+			Message:     i18n.T("engine.flow_blocked", flowName, flowResult.BlockingHop, flowResult.Reason),
+			Suggestion:  i18n.T("engine.flow_blocked.suggestion"),
+			DocumentRef: i18n.T("engine.step_ref", stepNumber),
+		})
+	}
+}
+
 func (e *Engine) determineStatus(result *ValidationResult) ValidationStatus {
 	if len(result.Errors) > 0 {
 		return StatusFailed
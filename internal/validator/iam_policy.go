@@ -0,0 +1,321 @@
+package validator
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sbcntr2-test-tool/internal/config"
+	"sbcntr2-test-tool/internal/i18n"
+	"strings"
+)
+
+// decodePolicyDocument はIAMのポリシードキュメント（URLエンコードされたJSON）をデコードし正規化する
+func decodePolicyDocument(document string) (map[string]interface{}, error) {
+	// IAM APIはポリシードキュメントをURLエンコードして返す
+	decoded, err := url.QueryUnescape(document)
+	if err == nil {
+		document = decoded
+	}
+
+	var policy map[string]interface{}
+	if err := json.Unmarshal([]byte(document), &policy); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal policy document: %w", err)
+	}
+
+	return normalizePolicyDocument(policy), nil
+}
+
+// normalizePolicyDocument はStatementを常に配列にし、
+// 各ステートメントのAction/NotAction/Resource/NotResourceも配列にそろえる
+// 例: {"Statement": {"Action": "s3:GetObject"}} -> {"Statement": [{"Action": ["s3:GetObject"]}]}
+func normalizePolicyDocument(policy map[string]interface{}) map[string]interface{} {
+	statements := toInterfaceSlice(policy["Statement"])
+
+	normalized := make([]interface{}, 0, len(statements))
+	for _, s := range statements {
+		statement, ok := s.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for _, key := range []string{"Action", "NotAction", "Resource", "NotResource"} {
+			if value, exists := statement[key]; exists {
+				statement[key] = toInterfaceSlice(value)
+			}
+		}
+		normalized = append(normalized, statement)
+	}
+
+	policy["Statement"] = normalized
+	return policy
+}
+
+// toInterfaceSlice は単一の値または配列を[]interface{}に変換する
+func toInterfaceSlice(value interface{}) []interface{} {
+	switch v := value.(type) {
+	case nil:
+		return []interface{}{}
+	case []interface{}:
+		return v
+	default:
+		return []interface{}{v}
+	}
+}
+
+// policyAllows はポリシードキュメント群がactionをresourceに対して許可するかを評価する
+// action・resourceにもワイルドカード（* と ?）を指定でき、一致するすべてのアクション・リソースが許可される場合にtrueを返す
+// （例: resourceが "arn:aws:s3:::bucket/*" なら、Allowのリソースが "arn:aws:s3:::bucket/*" や "*" のときだけ許可とみなす）
+// 一致するアクション・リソースの一部にでもかかるDenyがあれば許可しない。Allowは1つのステートメントで全体をカバーする必要がある
+// Conditionは評価せず、常に満たされるものとして扱う。NotAction・NotResourceを使うAllowは評価しない
+func policyAllows(documents []map[string]interface{}, action, resource string) bool {
+	allows, denies := splitStatements(documents)
+
+	for _, statement := range denies {
+		if denyApplies(statement, action, resource, patternsOverlap, patternCovers) {
+			return false
+		}
+	}
+
+	for _, statement := range allows {
+		if statementMatches(statement, action, resource, patternCovers) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// policyAllowsAny はresourceに一致するリソースの少なくとも1つに対してactionが許可されるかを評価する
+// （例: resourceが "arn:aws:secretsmanager:*:*:secret:*" なら、特定のシークレットだけを許可していてもよい）
+// 許可されたリソースまたはresource全体にかかるDenyがあれば、その許可は使わない
+func policyAllowsAny(documents []map[string]interface{}, action, resource string) bool {
+	allows, denies := splitStatements(documents)
+
+	deniedAll := func(target string) bool {
+		for _, statement := range denies {
+			if denyApplies(statement, action, target, patternCovers, patternsOverlap) {
+				return true
+			}
+		}
+		return false
+	}
+	if deniedAll(resource) {
+		return false
+	}
+
+	for _, statement := range allows {
+		if !matchesAnyPattern(toInterfaceSlice(statement["Action"]), action, true, patternCovers) {
+			continue
+		}
+		allowed := []interface{}{"*"}
+		if resources, ok := statement["Resource"]; ok {
+			allowed = toInterfaceSlice(resources)
+		}
+		for _, r := range allowed {
+			pattern, ok := r.(string)
+			if ok && patternsOverlap(pattern, resource, false) && !deniedAll(pattern) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// splitStatements はポリシードキュメント群のステートメントをAllowとDenyに分ける
+// NotAction・NotResourceを使うAllowは、許可の範囲を広く見積もらないよう除外する
+func splitStatements(documents []map[string]interface{}) (allows, denies []map[string]interface{}) {
+	for _, document := range documents {
+		for _, s := range toInterfaceSlice(document["Statement"]) {
+			statement, ok := s.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			switch statement["Effect"] {
+			case "Deny":
+				denies = append(denies, statement)
+			case "Allow":
+				_, notAction := statement["NotAction"]
+				_, notResource := statement["NotResource"]
+				if !notAction && !notResource {
+					allows = append(allows, statement)
+				}
+			}
+		}
+	}
+	return allows, denies
+}
+
+// denyApplies はDenyステートメントがactionとresourceに適用されるかを判定する
+// matchがpatternsOverlapなら一部にでも適用されるか、patternCoversならすべてに適用されるかを判定する
+// NotAction・NotResourceはそれに一致しないものに適用されるため、逆の判定となるnotMatchで評価する
+func denyApplies(statement map[string]interface{}, action, resource string, match, notMatch func(pattern, query string, ignoreCase bool) bool) bool {
+	if notActions, ok := statement["NotAction"]; ok {
+		if matchesAnyPattern(toInterfaceSlice(notActions), action, true, notMatch) {
+			return false
+		}
+	} else if !matchesAnyPattern(toInterfaceSlice(statement["Action"]), action, true, match) {
+		return false
+	}
+
+	if notResources, ok := statement["NotResource"]; ok {
+		return !matchesAnyPattern(toInterfaceSlice(notResources), resource, false, notMatch)
+	}
+	if resources, ok := statement["Resource"]; ok {
+		return matchesAnyPattern(toInterfaceSlice(resources), resource, false, match)
+	}
+	return true
+}
+
+// statementMatches はステートメントのAction・Resourceがmatchでactionとresourceに一致するかを判定する
+// Resourceがないステートメントはすべてのリソースに適用する
+func statementMatches(statement map[string]interface{}, action, resource string, match func(pattern, query string, ignoreCase bool) bool) bool {
+	// アクション名は大文字小文字を区別しない
+	if !matchesAnyPattern(toInterfaceSlice(statement["Action"]), action, true, match) {
+		return false
+	}
+
+	if resources, ok := statement["Resource"]; ok {
+		return matchesAnyPattern(toInterfaceSlice(resources), resource, false, match)
+	}
+	return true
+}
+
+// matchesAnyPattern は値がワイルドカード（* と ?）を含むパターンのいずれかにmatchで一致するかを判定する
+func matchesAnyPattern(patterns []interface{}, value string, ignoreCase bool, match func(pattern, query string, ignoreCase bool) bool) bool {
+	for _, p := range patterns {
+		pattern, ok := p.(string)
+		if !ok {
+			continue
+		}
+		if match(pattern, value, ignoreCase) {
+			return true
+		}
+	}
+	return false
+}
+
+// patternCovers はqueryに一致するすべての文字列がpatternにも一致するかを判定する
+// patternの * はqueryのワイルドカードを含む任意の並びに、? はqueryの1文字か ? に一致する
+// "?*" と "*?" のように並びの違う同じ集合はカバーしないと判定することがある（許可側に倒れることはない）
+func patternCovers(pattern, query string, ignoreCase bool) bool {
+	p, q := patternRunes(pattern, ignoreCase), patternRunes(query, ignoreCase)
+
+	// covered[i][j] は p[i:] が q[j:] をカバーするか
+	covered := make([][]bool, len(p)+1)
+	for i := range covered {
+		covered[i] = make([]bool, len(q)+1)
+	}
+	covered[len(p)][len(q)] = true
+
+	for i := len(p) - 1; i >= 0; i-- {
+		for j := len(q); j >= 0; j-- {
+			switch {
+			case p[i] == '*':
+				covered[i][j] = covered[i+1][j] || (j < len(q) && covered[i][j+1])
+			case j == len(q) || q[j] == '*':
+				covered[i][j] = false
+			case p[i] == '?' || p[i] == q[j]:
+				covered[i][j] = covered[i+1][j+1]
+			}
+		}
+	}
+
+	return covered[0][0]
+}
+
+// patternsOverlap はpatternとqueryの両方に一致する文字列があるかを判定する
+func patternsOverlap(pattern, query string, ignoreCase bool) bool {
+	p, q := patternRunes(pattern, ignoreCase), patternRunes(query, ignoreCase)
+
+	// overlaps[i][j] は p[i:] と q[j:] の両方に一致する文字列があるか
+	overlaps := make([][]bool, len(p)+1)
+	for i := range overlaps {
+		overlaps[i] = make([]bool, len(q)+1)
+	}
+
+	for i := len(p); i >= 0; i-- {
+		for j := len(q); j >= 0; j-- {
+			switch {
+			case i == len(p) && j == len(q):
+				overlaps[i][j] = true
+			case i < len(p) && p[i] == '*':
+				// * は空文字に一致するか、queryの1文字（* 以外）を消費する
+				overlaps[i][j] = overlaps[i+1][j] || (j < len(q) && q[j] != '*' && overlaps[i][j+1])
+				if j < len(q) && q[j] == '*' {
+					overlaps[i][j] = overlaps[i][j] || overlaps[i][j+1]
+				}
+			case j < len(q) && q[j] == '*':
+				overlaps[i][j] = overlaps[i][j+1] || (i < len(p) && overlaps[i+1][j])
+			case i < len(p) && j < len(q):
+				overlaps[i][j] = (p[i] == '?' || q[j] == '?' || p[i] == q[j]) && overlaps[i+1][j+1]
+			}
+		}
+	}
+
+	return overlaps[0][0]
+}
+
+func patternRunes(pattern string, ignoreCase bool) []rune {
+	if ignoreCase {
+		pattern = strings.ToLower(pattern)
+	}
+	return []rune(pattern)
+}
+
+// validateAllows は"allows"タイプのルールを評価する
+// expectedには action（または actions）と resource を指定する
+// resourceを省略した場合は "*"（すべてのリソース）への許可を求める
+// matchに "any" を指定すると、resourceに一致するリソースの少なくとも1つへの許可があればよい
+//
+//	expected:
+//	  actions: ["ecr:GetAuthorizationToken", "logs:PutLogEvents"]
+//	  resource: "*"
+func (v *ResourceValidator) validateAllows(actualProps map[string]interface{}, rule config.ValidationRule) error {
+	expected, ok := rule.Expected.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("expected of allows rule must be a map with action and resource")
+	}
+
+	var actions []string
+	if action, ok := expected["action"].(string); ok {
+		actions = append(actions, action)
+	}
+	for _, a := range toInterfaceSlice(expected["actions"]) {
+		if action, ok := a.(string); ok {
+			actions = append(actions, action)
+		}
+	}
+	if len(actions) == 0 {
+		return fmt.Errorf("allows rule requires action or actions")
+	}
+
+	resource := "*"
+	if r, ok := expected["resource"].(string); ok && r != "" {
+		resource = r
+	}
+
+	allows := policyAllows
+	switch match, _ := expected["match"].(string); match {
+	case "", "all":
+	case "any":
+		allows = policyAllowsAny
+	default:
+		return fmt.Errorf("unsupported match of allows rule: %s", match)
+	}
+
+	documents, _ := actualProps["PolicyDocuments"].([]map[string]interface{})
+
+	var denied []string
+	for _, action := range actions {
+		if !allows(documents, action, resource) {
+			denied = append(denied, action)
+		}
+	}
+
+	if len(denied) > 0 {
+		return i18n.Errorf("rule.not_allowed", rule.ErrorMessage, strings.Join(denied, ", "), resource)
+	}
+
+	return nil
+}
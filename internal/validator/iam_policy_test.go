@@ -0,0 +1,352 @@
+package validator
+
+import (
+	"testing"
+)
+
+func TestPatternCovers(t *testing.T) {
+	tests := []struct {
+		pattern    string
+		query      string
+		ignoreCase bool
+		want       bool
+	}{
+		{"*", "*", false, true},
+		{"*", "arn:aws:s3:::bucket/*", false, true},
+		{"arn:aws:s3:::bucket/*", "arn:aws:s3:::bucket/key", false, true},
+		{"arn:aws:s3:::bucket/*", "arn:aws:s3:::bucket/*", false, true},
+		{"arn:aws:s3:::bucket/*", "arn:aws:s3:::bucket/logs/*", false, true},
+		// 具体的なARNのAllowは、ワイルドカードを含むリソース全体はカバーしない
+		{"arn:aws:s3:::bucket/key", "arn:aws:s3:::bucket/*", false, false},
+		{"arn:aws:s3:::bucket/*", "*", false, false},
+		{"arn:aws:s3:::bucket/*", "arn:aws:s3:::other/key", false, false},
+		{"arn:aws:s3:::bucket/?", "arn:aws:s3:::bucket/a", false, true},
+		{"arn:aws:s3:::bucket/?", "arn:aws:s3:::bucket/?", false, true},
+		{"arn:aws:s3:::bucket/?", "arn:aws:s3:::bucket/*", false, false},
+		{"arn:aws:s3:::bucket/?", "arn:aws:s3:::bucket/ab", false, false},
+		{"arn:aws:s3:::Bucket/*", "arn:aws:s3:::bucket/key", false, false},
+		{"ecr:*", "ECR:GetAuthorizationToken", true, true},
+		{"ecr:get*", "ecr:GetAuthorizationToken", true, true},
+		{"ecr:Get*", "ecr:GetAuthorizationToken", false, true},
+		{"ecr:get*", "ecr:GetAuthorizationToken", false, false},
+		{"ecr:*", "logs:PutLogEvents", true, false},
+	}
+
+	for _, tt := range tests {
+		if got := patternCovers(tt.pattern, tt.query, tt.ignoreCase); got != tt.want {
+			t.Errorf("patternCovers(%q, %q, %v) = %v, want %v", tt.pattern, tt.query, tt.ignoreCase, got, tt.want)
+		}
+	}
+}
+
+func TestPatternsOverlap(t *testing.T) {
+	tests := []struct {
+		pattern    string
+		query      string
+		ignoreCase bool
+		want       bool
+	}{
+		{"*", "arn:aws:s3:::bucket/key", false, true},
+		{"arn:aws:s3:::bucket/key", "*", false, true},
+		{"arn:aws:s3:::bucket/secret/*", "arn:aws:s3:::bucket/*", false, true},
+		{"arn:aws:s3:::bucket/*", "arn:aws:s3:::*/key", false, true},
+		{"arn:aws:s3:::bucket/*", "arn:aws:s3:::other/*", false, false},
+		{"arn:aws:s3:::bucket/?", "arn:aws:s3:::bucket/ab", false, false},
+		{"arn:aws:s3:::bucket/?", "arn:aws:s3:::bucket/*", false, true},
+		{"a*c", "ab*", false, true},
+		{"a*c", "ab*d", false, false},
+		{"ECR:*", "ecr:BatchGetImage", true, true},
+		{"ECR:*", "ecr:BatchGetImage", false, false},
+	}
+
+	for _, tt := range tests {
+		if got := patternsOverlap(tt.pattern, tt.query, tt.ignoreCase); got != tt.want {
+			t.Errorf("patternsOverlap(%q, %q, %v) = %v, want %v", tt.pattern, tt.query, tt.ignoreCase, got, tt.want)
+		}
+	}
+}
+
+func TestPolicyAllows(t *testing.T) {
+	statement := func(effect string, actions, resources []interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"Effect":   effect,
+			"Action":   actions,
+			"Resource": resources,
+		}
+	}
+	document := func(statements ...map[string]interface{}) map[string]interface{} {
+		s := make([]interface{}, 0, len(statements))
+		for _, st := range statements {
+			s = append(s, st)
+		}
+		return map[string]interface{}{"Statement": s}
+	}
+
+	tests := []struct {
+		name      string
+		documents []map[string]interface{}
+		action    string
+		resource  string
+		want      bool
+	}{
+		{
+			name:      "allow all resources",
+			documents: []map[string]interface{}{document(statement("Allow", []interface{}{"ecr:GetAuthorizationToken"}, []interface{}{"*"}))},
+			action:    "ecr:GetAuthorizationToken",
+			resource:  "*",
+			want:      true,
+		},
+		{
+			name:      "action is case insensitive",
+			documents: []map[string]interface{}{document(statement("Allow", []interface{}{"ECR:getauthorizationtoken"}, []interface{}{"*"}))},
+			action:    "ecr:GetAuthorizationToken",
+			resource:  "*",
+			want:      true,
+		},
+		{
+			name:      "action wildcard",
+			documents: []map[string]interface{}{document(statement("Allow", []interface{}{"logs:*"}, []interface{}{"*"}))},
+			action:    "logs:PutLogEvents",
+			resource:  "*",
+			want:      true,
+		},
+		{
+			name:      "no matching statement",
+			documents: []map[string]interface{}{document(statement("Allow", []interface{}{"s3:GetObject"}, []interface{}{"*"}))},
+			action:    "logs:PutLogEvents",
+			resource:  "*",
+			want:      false,
+		},
+		{
+			name:      "specific resource does not cover all resources",
+			documents: []map[string]interface{}{document(statement("Allow", []interface{}{"secretsmanager:GetSecretValue"}, []interface{}{"arn:aws:secretsmanager:ap-northeast-1:123456789012:secret:sbcntr/mysql-abc"}))},
+			action:    "secretsmanager:GetSecretValue",
+			resource:  "*",
+			want:      false,
+		},
+		{
+			name:      "resource pattern covered by broader pattern",
+			documents: []map[string]interface{}{document(statement("Allow", []interface{}{"secretsmanager:GetSecretValue"}, []interface{}{"arn:aws:secretsmanager:*:*:secret:*"}))},
+			action:    "secretsmanager:GetSecretValue",
+			resource:  "arn:aws:secretsmanager:*:*:secret:sbcntr/*",
+			want:      true,
+		},
+		{
+			name:      "concrete resource covered by pattern",
+			documents: []map[string]interface{}{document(statement("Allow", []interface{}{"s3:GetObject"}, []interface{}{"arn:aws:s3:::bucket/*"}))},
+			action:    "s3:GetObject",
+			resource:  "arn:aws:s3:::bucket/key",
+			want:      true,
+		},
+		{
+			name: "explicit deny takes precedence",
+			documents: []map[string]interface{}{
+				document(statement("Allow", []interface{}{"s3:*"}, []interface{}{"*"})),
+				document(statement("Deny", []interface{}{"s3:GetObject"}, []interface{}{"*"})),
+			},
+			action:   "s3:GetObject",
+			resource: "*",
+			want:     false,
+		},
+		{
+			name: "deny before allow in the same document",
+			documents: []map[string]interface{}{document(
+				statement("Deny", []interface{}{"S3:GetObject"}, []interface{}{"*"}),
+				statement("Allow", []interface{}{"s3:GetObject"}, []interface{}{"*"}),
+			)},
+			action:   "s3:GetObject",
+			resource: "*",
+			want:     false,
+		},
+		{
+			name: "deny on part of the queried resources",
+			documents: []map[string]interface{}{document(
+				statement("Allow", []interface{}{"s3:GetObject"}, []interface{}{"arn:aws:s3:::bucket/*"}),
+				statement("Deny", []interface{}{"s3:GetObject"}, []interface{}{"arn:aws:s3:::bucket/secret/*"}),
+			)},
+			action:   "s3:GetObject",
+			resource: "arn:aws:s3:::bucket/*",
+			want:     false,
+		},
+		{
+			name: "deny on other resources",
+			documents: []map[string]interface{}{document(
+				statement("Allow", []interface{}{"s3:GetObject"}, []interface{}{"arn:aws:s3:::bucket/*"}),
+				statement("Deny", []interface{}{"s3:GetObject"}, []interface{}{"arn:aws:s3:::other/*"}),
+			)},
+			action:   "s3:GetObject",
+			resource: "arn:aws:s3:::bucket/key",
+			want:     true,
+		},
+		{
+			name: "not action statement is ignored",
+			documents: []map[string]interface{}{document(map[string]interface{}{
+				"Effect":    "Allow",
+				"NotAction": []interface{}{"iam:*"},
+				"Resource":  []interface{}{"*"},
+			})},
+			action:   "s3:GetObject",
+			resource: "*",
+			want:     false,
+		},
+		{
+			name: "deny with not action applies to other actions",
+			documents: []map[string]interface{}{document(
+				statement("Allow", []interface{}{"s3:*"}, []interface{}{"*"}),
+				map[string]interface{}{"Effect": "Deny", "NotAction": []interface{}{"iam:*"}, "Resource": []interface{}{"*"}},
+			)},
+			action:   "s3:GetObject",
+			resource: "*",
+			want:     false,
+		},
+		{
+			name: "deny with not action excludes the action",
+			documents: []map[string]interface{}{document(
+				statement("Allow", []interface{}{"s3:*"}, []interface{}{"*"}),
+				map[string]interface{}{"Effect": "Deny", "NotAction": []interface{}{"s3:*"}, "Resource": []interface{}{"*"}},
+			)},
+			action:   "s3:GetObject",
+			resource: "*",
+			want:     true,
+		},
+		{
+			name: "deny with not resource applies to other resources",
+			documents: []map[string]interface{}{document(
+				statement("Allow", []interface{}{"s3:GetObject"}, []interface{}{"*"}),
+				map[string]interface{}{"Effect": "Deny", "Action": []interface{}{"s3:GetObject"}, "NotResource": []interface{}{"arn:aws:s3:::bucket/*"}},
+			)},
+			action:   "s3:GetObject",
+			resource: "arn:aws:s3:::other/key",
+			want:     false,
+		},
+		{
+			name: "deny with not resource excludes the resource",
+			documents: []map[string]interface{}{document(
+				statement("Allow", []interface{}{"s3:GetObject"}, []interface{}{"*"}),
+				map[string]interface{}{"Effect": "Deny", "Action": []interface{}{"s3:GetObject"}, "NotResource": []interface{}{"arn:aws:s3:::bucket/*"}},
+			)},
+			action:   "s3:GetObject",
+			resource: "arn:aws:s3:::bucket/key",
+			want:     true,
+		},
+		{
+			name: "deny with not resource on part of the queried resources",
+			documents: []map[string]interface{}{document(
+				statement("Allow", []interface{}{"s3:GetObject"}, []interface{}{"*"}),
+				map[string]interface{}{"Effect": "Deny", "Action": []interface{}{"s3:GetObject"}, "NotResource": []interface{}{"arn:aws:s3:::bucket/public/*"}},
+			)},
+			action:   "s3:GetObject",
+			resource: "arn:aws:s3:::bucket/*",
+			want:     false,
+		},
+		{
+			name:      "no documents",
+			documents: nil,
+			action:    "s3:GetObject",
+			resource:  "*",
+			want:      false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := policyAllows(tt.documents, tt.action, tt.resource); got != tt.want {
+				t.Errorf("policyAllows(%q, %q) = %v, want %v", tt.action, tt.resource, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPolicyAllowsAny(t *testing.T) {
+	statement := func(effect string, actions, resources []interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"Effect":   effect,
+			"Action":   actions,
+			"Resource": resources,
+		}
+	}
+	document := func(statements ...map[string]interface{}) map[string]interface{} {
+		s := make([]interface{}, 0, len(statements))
+		for _, st := range statements {
+			s = append(s, st)
+		}
+		return map[string]interface{}{"Statement": s}
+	}
+	const secrets = "arn:aws:secretsmanager:*:*:secret:*"
+	const secret = "arn:aws:secretsmanager:ap-northeast-1:123456789012:secret:sbcntr/mysql-abc"
+
+	tests := []struct {
+		name      string
+		documents []map[string]interface{}
+		want      bool
+	}{
+		{
+			name:      "specific secret",
+			documents: []map[string]interface{}{document(statement("Allow", []interface{}{"secretsmanager:GetSecretValue"}, []interface{}{secret}))},
+			want:      true,
+		},
+		{
+			name:      "all resources",
+			documents: []map[string]interface{}{document(statement("Allow", []interface{}{"secretsmanager:*"}, []interface{}{"*"}))},
+			want:      true,
+		},
+		{
+			name:      "other service",
+			documents: []map[string]interface{}{document(statement("Allow", []interface{}{"secretsmanager:GetSecretValue"}, []interface{}{"arn:aws:ssm:*:*:parameter/*"}))},
+			want:      false,
+		},
+		{
+			name:      "other action",
+			documents: []map[string]interface{}{document(statement("Allow", []interface{}{"secretsmanager:ListSecrets"}, []interface{}{secret}))},
+			want:      false,
+		},
+		{
+			name: "allowed secret is denied",
+			documents: []map[string]interface{}{document(
+				statement("Allow", []interface{}{"secretsmanager:GetSecretValue"}, []interface{}{secret}),
+				statement("Deny", []interface{}{"secretsmanager:GetSecretValue"}, []interface{}{secret}),
+			)},
+			want: false,
+		},
+		{
+			name: "all secrets are denied",
+			documents: []map[string]interface{}{document(
+				statement("Allow", []interface{}{"secretsmanager:GetSecretValue"}, []interface{}{"*"}),
+				statement("Deny", []interface{}{"secretsmanager:*"}, []interface{}{secrets}),
+			)},
+			want: false,
+		},
+		{
+			name: "deny with not resource on other secrets",
+			documents: []map[string]interface{}{document(
+				statement("Allow", []interface{}{"secretsmanager:GetSecretValue"}, []interface{}{"*"}),
+				map[string]interface{}{"Effect": "Deny", "Action": []interface{}{"secretsmanager:*"}, "NotResource": []interface{}{secret}},
+			)},
+			want: true,
+		},
+		{
+			name: "deny with not action on all secrets",
+			documents: []map[string]interface{}{document(
+				statement("Allow", []interface{}{"secretsmanager:GetSecretValue"}, []interface{}{secret}),
+				map[string]interface{}{"Effect": "Deny", "NotAction": []interface{}{"s3:*"}, "Resource": []interface{}{"*"}},
+			)},
+			want: false,
+		},
+		{
+			name: "deny on another secret",
+			documents: []map[string]interface{}{document(
+				statement("Allow", []interface{}{"secretsmanager:GetSecretValue"}, []interface{}{secret}),
+				statement("Deny", []interface{}{"secretsmanager:GetSecretValue"}, []interface{}{"arn:aws:secretsmanager:*:*:secret:admin-*"}),
+			)},
+			want: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := policyAllowsAny(tt.documents, "secretsmanager:GetSecretValue", secrets); got != tt.want {
+				t.Errorf("policyAllowsAny() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
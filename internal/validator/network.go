@@ -0,0 +1,193 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	awsutil "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// fargateRequiredEndpoints はNATゲートウェイなしでFargateタスクを起動するのに必要なVPCエンドポイント
+// ECRからのイメージ取得（ecr.api, ecr.dkr, s3）とawslogsドライバー（logs）
+var fargateRequiredEndpoints = []string{"ecr.api", "ecr.dkr", "s3", "logs"}
+
+// getSubnetRouteTable はサブネットに関連付けられたルートテーブルを取得する
+// 明示的な関連付けがない場合はVPCのメインルートテーブルを返す
+func (v *ResourceValidator) getSubnetRouteTable(ctx context.Context, subnetID, vpcID string) (*ec2types.RouteTable, error) {
+	result, err := v.awsClient.EC2.DescribeRouteTables(ctx, &ec2.DescribeRouteTablesInput{
+		Filters: []ec2types.Filter{
+			{
+				Name:   awsutil.String("association.subnet-id"),
+				Values: []string{subnetID},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(result.RouteTables) > 0 {
+		return &result.RouteTables[0], nil
+	}
+
+	result, err = v.awsClient.EC2.DescribeRouteTables(ctx, &ec2.DescribeRouteTablesInput{
+		Filters: []ec2types.Filter{
+			{
+				Name:   awsutil.String("vpc-id"),
+				Values: []string{vpcID},
+			},
+			{
+				Name:   awsutil.String("association.main"),
+				Values: []string{"true"},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(result.RouteTables) == 0 {
+		return nil, fmt.Errorf("route table for subnet %s not found", subnetID)
+	}
+
+	return &result.RouteTables[0], nil
+}
+
+// normalizeRoutes はルートテーブルのルートをmapに変換する
+func normalizeRoutes(routes []ec2types.Route) []map[string]interface{} {
+	normalized := []map[string]interface{}{}
+	for _, route := range routes {
+		r := map[string]interface{}{
+			"State":  string(route.State),
+			"Origin": string(route.Origin),
+		}
+		if route.DestinationCidrBlock != nil {
+			r["DestinationCidrBlock"] = *route.DestinationCidrBlock
+		}
+		if route.DestinationPrefixListId != nil {
+			r["DestinationPrefixListId"] = *route.DestinationPrefixListId
+		}
+		if route.GatewayId != nil {
+			r["GatewayId"] = *route.GatewayId
+		}
+		if route.NatGatewayId != nil {
+			r["NatGatewayId"] = *route.NatGatewayId
+		}
+		if route.TransitGatewayId != nil {
+			r["TransitGatewayId"] = *route.TransitGatewayId
+		}
+		if route.VpcPeeringConnectionId != nil {
+			r["VpcPeeringConnectionId"] = *route.VpcPeeringConnectionId
+		}
+		normalized = append(normalized, r)
+	}
+	return normalized
+}
+
+// endpointServiceShortName はVPCエンドポイントのサービス名から短い名前を取り出す
+// 例: com.amazonaws.ap-northeast-1.ecr.api -> ecr.api
+func endpointServiceShortName(serviceName string) string {
+	parts := strings.SplitN(serviceName, ".", 4)
+	if len(parts) < 4 {
+		return serviceName
+	}
+	return parts[3]
+}
+
+// addEgressPath はサブネットのルートテーブルとVPCエンドポイントから外部への経路を判定してpropsに追加する
+//
+//	EgressPath: "internet-gateway" | "nat-gateway" | "vpc-endpoints" | "none"
+//
+// NATゲートウェイがなく、ECR/S3/logsのエンドポイントも不足している場合は
+// Fargateタスクの起動時にCannotPullContainerErrorとなる
+func (v *ResourceValidator) addEgressPath(ctx context.Context, subnetID, vpcID string, props map[string]interface{}) {
+	routeTable, err := v.getSubnetRouteTable(ctx, subnetID, vpcID)
+	if err != nil {
+		return
+	}
+
+	routeTableID := awsutil.ToString(routeTable.RouteTableId)
+	props["RouteTableId"] = routeTableID
+	props["Routes"] = normalizeRoutes(routeTable.Routes)
+
+	hasInternetGateway := false
+	hasNatGateway := false
+	for _, route := range routeTable.Routes {
+		if awsutil.ToString(route.DestinationCidrBlock) != "0.0.0.0/0" || route.State != ec2types.RouteStateActive {
+			continue
+		}
+		if strings.HasPrefix(awsutil.ToString(route.GatewayId), "igw-") {
+			hasInternetGateway = true
+		}
+		if route.NatGatewayId != nil {
+			hasNatGateway = true
+		}
+	}
+	props["HasInternetGatewayRoute"] = hasInternetGateway
+	props["HasNatGatewayRoute"] = hasNatGateway
+
+	// サブネットから利用できるVPCエンドポイント
+	// Gateway型はルートテーブルに関連付けられている場合、Interface型はVPC内にあれば利用できる
+	endpoints := []string{}
+	endpointResult, err := v.awsClient.EC2.DescribeVpcEndpoints(ctx, &ec2.DescribeVpcEndpointsInput{
+		Filters: []ec2types.Filter{
+			{
+				Name:   awsutil.String("vpc-id"),
+				Values: []string{vpcID},
+			},
+			{
+				Name:   awsutil.String("vpc-endpoint-state"),
+				Values: []string{"available"},
+			},
+		},
+	})
+	if err == nil {
+		for _, endpoint := range endpointResult.VpcEndpoints {
+			usable := endpoint.VpcEndpointType != ec2types.VpcEndpointTypeGateway
+			for _, rtID := range endpoint.RouteTableIds {
+				if rtID == routeTableID {
+					usable = true
+				}
+			}
+			if usable {
+				endpoints = append(endpoints, endpointServiceShortName(awsutil.ToString(endpoint.ServiceName)))
+			}
+		}
+	}
+	props["ReachableEndpointServices"] = endpoints
+
+	missing := []string{}
+	for _, required := range fargateRequiredEndpoints {
+		found := false
+		for _, endpoint := range endpoints {
+			if endpoint == required {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, required)
+		}
+	}
+
+	switch {
+	case hasInternetGateway:
+		props["EgressPath"] = "internet-gateway"
+	case hasNatGateway:
+		props["EgressPath"] = "nat-gateway"
+	case len(endpoints) > 0:
+		props["EgressPath"] = "vpc-endpoints"
+	default:
+		props["EgressPath"] = "none"
+	}
+
+	// NATゲートウェイやインターネットゲートウェイがあればエンドポイントは不要
+	if hasInternetGateway || hasNatGateway {
+		props["MissingFargateEndpoints"] = []string{}
+		props["CanPullFromECR"] = true
+	} else {
+		props["MissingFargateEndpoints"] = missing
+		props["CanPullFromECR"] = len(missing) == 0
+	}
+}
@@ -0,0 +1,59 @@
+package validator
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+)
+
+// RunInfo は1回の検証（--step または --all）の実行
+type RunInfo struct {
+	ID        string
+	Steps     []int
+	StartedAt time.Time
+}
+
+// Observer は検証の進行を受け取る
+// エンジンは検証が終わるのを待たず、ステップやリソース、検証ルールを評価するたびに呼び出す
+type Observer interface {
+	RunStarted(run RunInfo)
+	StepStarted(run RunInfo, stepNumber int, stepName string)
+	// resourceは評価途中のリソースで、TypeとNameだけが確定している
+	RuleEvaluated(run RunInfo, stepNumber int, resource *ResourceResult, rule RuleResult)
+	ResourceChecked(run RunInfo, stepNumber int, resource *ResourceResult)
+	StepFinished(run RunInfo, result *ValidationResult)
+	RunFinished(run RunInfo, summary *ValidationSummary)
+}
+
+// AddObserver は検証の進行を受け取るオブザーバーを追加する
+func (e *Engine) AddObserver(observer Observer) {
+	e.observers = append(e.observers, observer)
+}
+
+func (e *Engine) notify(fn func(Observer)) {
+	for _, observer := range e.observers {
+		fn(observer)
+	}
+}
+
+func (e *Engine) startRun(steps []int) {
+	e.run = RunInfo{
+		ID:        newRunID(),
+		Steps:     steps,
+		StartedAt: time.Now(),
+	}
+	e.notify(func(o Observer) { o.RunStarted(e.run) })
+}
+
+func (e *Engine) finishRun(summary *ValidationSummary) {
+	e.notify(func(o Observer) { o.RunFinished(e.run, summary) })
+}
+
+// newRunID は実行ごとに一意なIDを返す
+func newRunID() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return time.Now().Format("20060102150405.000000000")
+	}
+	return hex.EncodeToString(b)
+}
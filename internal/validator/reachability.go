@@ -0,0 +1,475 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sbcntr2-test-tool/internal/config"
+	"sbcntr2-test-tool/internal/i18n"
+	"sort"
+	"strings"
+
+	awsutil "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// returnTrafficPort は戻りの通信の評価に使うエフェメラルポートの代表値
+// NACLはステートレスのため、戻りの通信も明示的に許可されている必要がある
+const returnTrafficPort = 32768
+
+// protocolNumbers はプロトコル名とIPプロトコル番号の対応（NACLは番号で返される）
+var protocolNumbers = map[string]string{
+	"tcp":  "6",
+	"udp":  "17",
+	"icmp": "1",
+}
+
+// flowEndpoint はフローの送信元・宛先となるセキュリティグループとその配置先
+type flowEndpoint struct {
+	Name         string
+	GroupID      string
+	IngressRules []map[string]interface{}
+	EgressRules  []map[string]interface{}
+	// サブネットIDごとの代表的なプライベートIP（セキュリティグループが割り当てられたENIから取得）
+	SubnetIPs map[string]string
+}
+
+// subnetNetwork はサブネットに関連付けられたNACLとルートテーブル
+type subnetNetwork struct {
+	NetworkAcl *ec2types.NetworkAcl
+	RouteTable *ec2types.RouteTable
+}
+
+// ReachabilityAnalyzer はセキュリティグループ、NACL、ルートテーブルから通信経路の到達性を評価する
+// AWSから取得した情報は評価をまたいでキャッシュする
+type ReachabilityAnalyzer struct {
+	validator *ResourceValidator
+	endpoints map[string]*flowEndpoint
+	subnets   map[string]*subnetNetwork
+}
+
+func NewReachabilityAnalyzer(validator *ResourceValidator) *ReachabilityAnalyzer {
+	return &ReachabilityAnalyzer{
+		validator: validator,
+		endpoints: make(map[string]*flowEndpoint),
+		subnets:   make(map[string]*subnetNetwork),
+	}
+}
+
+// Evaluate は宣言された通信経路を評価し、最初に通信を遮断している箇所を返す
+func (a *ReachabilityAnalyzer) Evaluate(ctx context.Context, flow config.FlowDefinition) FlowResult {
+	protocol := strings.ToLower(flow.Protocol)
+	if protocol == "" {
+		protocol = "tcp"
+	}
+
+	result := FlowResult{
+		From:     flow.From,
+		To:       flow.To,
+		Protocol: protocol,
+		Port:     flow.Port,
+		Hops:     []FlowHop{},
+	}
+
+	src, err := a.getEndpoint(ctx, flow.From)
+	if err != nil {
+		result.Reason = err.Error()
+		return result
+	}
+	dst, err := a.getEndpoint(ctx, flow.To)
+	if err != nil {
+		result.Reason = err.Error()
+		return result
+	}
+
+	return evaluateFlow(result, src, dst, a.subnets)
+}
+
+// getEndpoint はセキュリティグループのルールと、そのセキュリティグループを持つENIの配置先を取得する
+func (a *ReachabilityAnalyzer) getEndpoint(ctx context.Context, sgName string) (*flowEndpoint, error) {
+	if endpoint, ok := a.endpoints[sgName]; ok {
+		return endpoint, nil
+	}
+
+	exists, props, err := a.validator.checkSecurityGroup(ctx, sgName)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, i18n.Errorf("flow.sg_not_found", sgName)
+	}
+
+	endpoint := &flowEndpoint{
+		Name:      sgName,
+		GroupID:   props["GroupId"].(string),
+		SubnetIPs: make(map[string]string),
+	}
+	endpoint.IngressRules, _ = props["IngressRules"].([]map[string]interface{})
+	endpoint.EgressRules, _ = props["EgressRules"].([]map[string]interface{})
+
+	paginator := ec2.NewDescribeNetworkInterfacesPaginator(a.validator.awsClient.EC2, &ec2.DescribeNetworkInterfacesInput{
+		Filters: []ec2types.Filter{
+			{
+				Name:   awsutil.String("group-id"),
+				Values: []string{endpoint.GroupID},
+			},
+		},
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe network interfaces for %s: %w", sgName, err)
+		}
+		for _, eni := range page.NetworkInterfaces {
+			subnetID := awsutil.ToString(eni.SubnetId)
+			if subnetID == "" {
+				continue
+			}
+			if _, ok := endpoint.SubnetIPs[subnetID]; !ok {
+				endpoint.SubnetIPs[subnetID] = awsutil.ToString(eni.PrivateIpAddress)
+			}
+			if err := a.loadSubnetNetwork(ctx, subnetID, awsutil.ToString(eni.VpcId)); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	a.endpoints[sgName] = endpoint
+	return endpoint, nil
+}
+
+// loadSubnetNetwork はサブネットのNACLとルートテーブルを取得してキャッシュする
+func (a *ReachabilityAnalyzer) loadSubnetNetwork(ctx context.Context, subnetID, vpcID string) error {
+	if _, ok := a.subnets[subnetID]; ok {
+		return nil
+	}
+
+	network := &subnetNetwork{}
+
+	acls, err := a.validator.awsClient.EC2.DescribeNetworkAcls(ctx, &ec2.DescribeNetworkAclsInput{
+		Filters: []ec2types.Filter{
+			{
+				Name:   awsutil.String("association.subnet-id"),
+				Values: []string{subnetID},
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to describe network ACL for %s: %w", subnetID, err)
+	}
+	if len(acls.NetworkAcls) > 0 {
+		network.NetworkAcl = &acls.NetworkAcls[0]
+	}
+
+	routeTable, err := a.validator.getSubnetRouteTable(ctx, subnetID, vpcID)
+	if err != nil {
+		return fmt.Errorf("failed to describe route table for %s: %w", subnetID, err)
+	}
+	network.RouteTable = routeTable
+
+	a.subnets[subnetID] = network
+	return nil
+}
+
+// evaluateFlow は取得済みの情報だけを使って通信経路を評価する（AWS APIは呼び出さない）
+// 送信元と宛先のサブネットの全組み合わせを評価し、最初に遮断された組み合わせの結果を返す
+func evaluateFlow(result FlowResult, src, dst *flowEndpoint, subnets map[string]*subnetNetwork) FlowResult {
+	srcSubnets := sortedSubnetIDs(src.SubnetIPs)
+	dstSubnets := sortedSubnetIDs(dst.SubnetIPs)
+
+	// ENIがない（タスクが起動していない等）場合はIPが分からないため、セキュリティグループのみ評価する
+	// どのルールにも一致し得ない場合だけ遮断とし、それ以外は判定できない（警告）とする
+	if len(srcSubnets) == 0 || len(dstSubnets) == 0 {
+		hops := []FlowHop{
+			securityGroupHop(src, dst, "", result.Protocol, result.Port, true),
+			securityGroupHop(dst, src, "", result.Protocol, result.Port, false),
+		}
+		result = finishFlow(result, hops)
+		if result.Reachable {
+			result.Reachable = false
+			result.Indeterminate = true
+			result.Reason = i18n.T("flow.no_interfaces")
+		}
+		return result
+	}
+
+	var first []FlowHop
+	for _, srcSubnet := range srcSubnets {
+		for _, dstSubnet := range dstSubnets {
+			hops := evaluatePath(result.Protocol, result.Port, src, dst, srcSubnet, dstSubnet, subnets)
+			if first == nil {
+				first = hops
+			}
+			for _, hop := range hops {
+				if !hop.Allowed {
+					return finishFlow(result, hops)
+				}
+			}
+		}
+	}
+
+	return finishFlow(result, first)
+}
+
+// evaluatePath は送信元サブネットから宛先サブネットへの行きと戻りの通信を順に評価する
+func evaluatePath(protocol string, port int, src, dst *flowEndpoint, srcSubnet, dstSubnet string, subnets map[string]*subnetNetwork) []FlowHop {
+	srcIP := src.SubnetIPs[srcSubnet]
+	dstIP := dst.SubnetIPs[dstSubnet]
+	srcNetwork := subnets[srcSubnet]
+	dstNetwork := subnets[dstSubnet]
+	// 同じサブネット内の通信にはNACLは適用されない
+	crossSubnet := srcSubnet != dstSubnet
+
+	hops := []FlowHop{securityGroupHop(src, dst, dstIP, protocol, port, true)}
+
+	if srcNetwork != nil && srcNetwork.RouteTable != nil {
+		hops = append(hops, routeHop(srcNetwork.RouteTable, srcSubnet, dstIP))
+	}
+	if crossSubnet && srcNetwork != nil && srcNetwork.NetworkAcl != nil {
+		hops = append(hops, networkAclHop(srcNetwork.NetworkAcl, srcSubnet, "outbound", protocol, port, dstIP))
+	}
+	if crossSubnet && dstNetwork != nil && dstNetwork.NetworkAcl != nil {
+		hops = append(hops, networkAclHop(dstNetwork.NetworkAcl, dstSubnet, "inbound", protocol, port, srcIP))
+	}
+
+	hops = append(hops, securityGroupHop(dst, src, srcIP, protocol, port, false))
+
+	// 戻りの通信（セキュリティグループはステートフルのため評価不要）
+	if dstNetwork != nil && dstNetwork.RouteTable != nil {
+		hops = append(hops, routeHop(dstNetwork.RouteTable, dstSubnet, srcIP))
+	}
+	if crossSubnet && dstNetwork != nil && dstNetwork.NetworkAcl != nil {
+		hops = append(hops, networkAclHop(dstNetwork.NetworkAcl, dstSubnet, "return-outbound", protocol, returnTrafficPort, srcIP))
+	}
+	if crossSubnet && srcNetwork != nil && srcNetwork.NetworkAcl != nil {
+		hops = append(hops, networkAclHop(srcNetwork.NetworkAcl, srcSubnet, "return-inbound", protocol, returnTrafficPort, dstIP))
+	}
+
+	return hops
+}
+
+// finishFlow は評価結果から到達可否と最初に遮断された箇所を設定する
+func finishFlow(result FlowResult, hops []FlowHop) FlowResult {
+	result.Hops = hops
+	result.Reachable = true
+	for _, hop := range hops {
+		if !hop.Allowed {
+			result.Reachable = false
+			result.BlockingHop = hop.Name
+			result.Reason = hop.Detail
+			break
+		}
+	}
+	return result
+}
+
+// securityGroupHop はendpointのセキュリティグループがpeerとの通信を許可するかを評価する
+// egressがtrueならアウトバウンドルール、falseならインバウンドルールを評価する
+// peerIPが空（ENIがなくIPが分からない）の場合、プロトコルとポートが一致するCIDRのルールは一致し得るものとして許可する
+func securityGroupHop(endpoint, peer *flowEndpoint, peerIP, protocol string, port int, egress bool) FlowHop {
+	rules := endpoint.IngressRules
+	peerPrefix := "Source"
+	direction := "ingress"
+	if egress {
+		rules = endpoint.EgressRules
+		peerPrefix = "Destination"
+		direction = "egress"
+	}
+
+	hop := FlowHop{Name: fmt.Sprintf("security-group-%s %s", direction, endpoint.Name)}
+	peerDesc := peer.Name
+	if peerIP != "" {
+		peerDesc = fmt.Sprintf("%s (%s)", peer.Name, peerIP)
+	}
+
+	// プレフィックスリストの中身は取得しないため評価できない
+	hasPrefixList := false
+	possibleCIDR := ""
+	for _, rule := range rules {
+		ruleProtocol, _ := rule["IpProtocol"].(string)
+		if !protocolMatches(ruleProtocol, protocol) {
+			continue
+		}
+		if ruleProtocol != "-1" && !portInRange(rule["FromPort"], rule["ToPort"], port) {
+			continue
+		}
+
+		groups, _ := rule[peerPrefix+"SecurityGroups"].([]string)
+		for _, group := range groups {
+			if group == peer.GroupID {
+				hop.Allowed = true
+				hop.Detail = i18n.T("flow.sg_allowed_group", peer.Name)
+				return hop
+			}
+		}
+
+		cidrs, _ := rule["CidrBlocks"].([]string)
+		for _, cidr := range cidrs {
+			if cidrContains(cidr, peerIP) {
+				hop.Allowed = true
+				hop.Detail = i18n.T("flow.sg_allowed_cidr", cidr)
+				return hop
+			}
+			if _, _, err := net.ParseCIDR(cidr); err == nil && peerIP == "" && possibleCIDR == "" {
+				possibleCIDR = cidr
+			}
+		}
+
+		if prefixLists, _ := rule["PrefixListIds"].([]string); len(prefixLists) > 0 {
+			hasPrefixList = true
+		}
+	}
+
+	if possibleCIDR != "" {
+		hop.Allowed = true
+		hop.Detail = i18n.T("flow.sg_maybe_allowed_cidr", possibleCIDR)
+		return hop
+	}
+
+	hop.Detail = i18n.T("flow.sg_denied", direction, protocol, port, peerDesc)
+	if hasPrefixList {
+		hop.Detail += i18n.T("flow.sg_prefix_lists")
+	}
+	return hop
+}
+
+// networkAclHop はNACLのエントリをルール番号順に評価する
+// directionが"outbound"または"return-outbound"ならアウトバウンドのエントリを評価する
+func networkAclHop(acl *ec2types.NetworkAcl, subnetID, direction, protocol string, port int, peerIP string) FlowHop {
+	egress := strings.HasSuffix(direction, "outbound")
+	hop := FlowHop{Name: fmt.Sprintf("network-acl-%s %s (%s)", direction, awsutil.ToString(acl.NetworkAclId), subnetID)}
+
+	entries := make([]ec2types.NetworkAclEntry, len(acl.Entries))
+	copy(entries, acl.Entries)
+	sort.Slice(entries, func(i, j int) bool {
+		return awsutil.ToInt32(entries[i].RuleNumber) < awsutil.ToInt32(entries[j].RuleNumber)
+	})
+
+	for _, entry := range entries {
+		if awsutil.ToBool(entry.Egress) != egress || entry.CidrBlock == nil {
+			continue
+		}
+		entryProtocol := awsutil.ToString(entry.Protocol)
+		if !protocolMatches(entryProtocol, protocol) {
+			continue
+		}
+		if entryProtocol != "-1" && entry.PortRange != nil {
+			if int32(port) < awsutil.ToInt32(entry.PortRange.From) || int32(port) > awsutil.ToInt32(entry.PortRange.To) {
+				continue
+			}
+		}
+		if !cidrContains(*entry.CidrBlock, peerIP) {
+			continue
+		}
+
+		ruleNumber := fmt.Sprintf("#%d", awsutil.ToInt32(entry.RuleNumber))
+		if awsutil.ToInt32(entry.RuleNumber) == 32767 {
+			ruleNumber = "*"
+		}
+		if entry.RuleAction == ec2types.RuleActionAllow {
+			hop.Allowed = true
+			hop.Detail = i18n.T("flow.acl_allowed", ruleNumber, *entry.CidrBlock)
+		} else {
+			hop.Detail = i18n.T("flow.acl_denied", protocol, port, ruleNumber, *entry.CidrBlock)
+		}
+		return hop
+	}
+
+	hop.Detail = i18n.T("flow.acl_no_match", protocol, port, peerIP)
+	return hop
+}
+
+// routeHop はルートテーブルに宛先IPへの有効なルートがあるかを最長一致で評価する
+func routeHop(routeTable *ec2types.RouteTable, subnetID, destinationIP string) FlowHop {
+	hop := FlowHop{Name: fmt.Sprintf("route-table %s (%s)", awsutil.ToString(routeTable.RouteTableId), subnetID)}
+
+	ip := net.ParseIP(destinationIP)
+	var best *ec2types.Route
+	bestPrefix := -1
+	for i, route := range routeTable.Routes {
+		if route.DestinationCidrBlock == nil || ip == nil {
+			continue
+		}
+		_, network, err := net.ParseCIDR(*route.DestinationCidrBlock)
+		if err != nil || !network.Contains(ip) {
+			continue
+		}
+		if prefix, _ := network.Mask.Size(); prefix > bestPrefix {
+			best = &routeTable.Routes[i]
+			bestPrefix = prefix
+		}
+	}
+
+	if best == nil {
+		hop.Detail = i18n.T("flow.no_route", destinationIP)
+		return hop
+	}
+	if best.State == ec2types.RouteStateBlackhole {
+		hop.Detail = i18n.T("flow.blackhole", awsutil.ToString(best.DestinationCidrBlock))
+		return hop
+	}
+
+	target := awsutil.ToString(best.GatewayId)
+	for _, candidate := range []*string{best.NatGatewayId, best.TransitGatewayId, best.VpcPeeringConnectionId, best.NetworkInterfaceId} {
+		if candidate != nil {
+			target = *candidate
+		}
+	}
+	hop.Allowed = true
+	hop.Detail = i18n.T("flow.routed", target, awsutil.ToString(best.DestinationCidrBlock))
+	return hop
+}
+
+// protocolMatches はルールのプロトコル（名前または番号、-1は全て）が評価対象のプロトコルに一致するかを判定する
+func protocolMatches(ruleProtocol, protocol string) bool {
+	if ruleProtocol == "-1" || ruleProtocol == "all" {
+		return true
+	}
+	if number, ok := protocolNumbers[ruleProtocol]; ok {
+		ruleProtocol = number
+	}
+	if number, ok := protocolNumbers[protocol]; ok {
+		protocol = number
+	}
+	return ruleProtocol == protocol
+}
+
+// portInRange はセキュリティグループのルールのポート範囲にportが含まれるかを判定する
+func portInRange(fromPort, toPort interface{}, port int) bool {
+	from, ok := toFloat64(fromPort)
+	if !ok {
+		return true
+	}
+	to, ok := toFloat64(toPort)
+	if !ok {
+		to = from
+	}
+	// ICMP等ではポートに-1が設定される
+	if from == -1 {
+		return true
+	}
+	return float64(port) >= from && float64(port) <= to
+}
+
+// cidrContains はCIDRにIPが含まれるかを判定する。IPが不明な場合は0.0.0.0/0のみ一致とみなす
+func cidrContains(cidr, ip string) bool {
+	if ip == "" {
+		return cidr == "0.0.0.0/0"
+	}
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return false
+	}
+	parsed := net.ParseIP(ip)
+	return parsed != nil && network.Contains(parsed)
+}
+
+func sortedSubnetIDs(subnetIPs map[string]string) []string {
+	ids := make([]string, 0, len(subnetIPs))
+	for id := range subnetIPs {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
@@ -0,0 +1,521 @@
+package validator
+
+import (
+	"strings"
+	"testing"
+
+	awsutil "github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+func sgRule(protocol string, from, to int32, groups, cidrs []string, egress bool) map[string]interface{} {
+	prefix := "Source"
+	if egress {
+		prefix = "Destination"
+	}
+	return map[string]interface{}{
+		"IpProtocol":              protocol,
+		"FromPort":                from,
+		"ToPort":                  to,
+		prefix + "SecurityGroups": groups,
+		"CidrBlocks":              cidrs,
+	}
+}
+
+func aclEntry(number int32, egress bool, protocol string, from, to int32, cidr string, action ec2types.RuleAction) ec2types.NetworkAclEntry {
+	entry := ec2types.NetworkAclEntry{
+		RuleNumber: awsutil.Int32(number),
+		Egress:     awsutil.Bool(egress),
+		Protocol:   awsutil.String(protocol),
+		CidrBlock:  awsutil.String(cidr),
+		RuleAction: action,
+	}
+	if protocol != "-1" {
+		entry.PortRange = &ec2types.PortRange{From: awsutil.Int32(from), To: awsutil.Int32(to)}
+	}
+	return entry
+}
+
+func networkAcl(entries ...ec2types.NetworkAclEntry) *ec2types.NetworkAcl {
+	return &ec2types.NetworkAcl{NetworkAclId: awsutil.String("acl-1"), Entries: entries}
+}
+
+func route(cidr string, gatewayID string, state ec2types.RouteState) ec2types.Route {
+	return ec2types.Route{
+		DestinationCidrBlock: awsutil.String(cidr),
+		GatewayId:            awsutil.String(gatewayID),
+		State:                state,
+	}
+}
+
+func routeTable(routes ...ec2types.Route) *ec2types.RouteTable {
+	return &ec2types.RouteTable{RouteTableId: awsutil.String("rtb-1"), Routes: routes}
+}
+
+// allowAllAcl は全ての通信を許可するNACL（デフォルトNACL相当）
+func allowAllAcl() *ec2types.NetworkAcl {
+	return networkAcl(
+		aclEntry(100, false, "-1", 0, 0, "0.0.0.0/0", ec2types.RuleActionAllow),
+		aclEntry(100, true, "-1", 0, 0, "0.0.0.0/0", ec2types.RuleActionAllow),
+	)
+}
+
+func localRouteTable() *ec2types.RouteTable {
+	return routeTable(route("10.0.0.0/16", "local", ec2types.RouteStateActive))
+}
+
+func TestCidrContains(t *testing.T) {
+	tests := []struct {
+		cidr string
+		ip   string
+		want bool
+	}{
+		{"10.0.0.0/16", "10.0.1.5", true},
+		{"10.0.0.0/24", "10.0.1.5", false},
+		{"10.0.1.5/32", "10.0.1.5", true},
+		{"0.0.0.0/0", "192.168.0.1", true},
+		// IPが不明な場合は0.0.0.0/0のみ一致とみなす
+		{"0.0.0.0/0", "", true},
+		{"10.0.0.0/16", "", false},
+		{"invalid", "10.0.1.5", false},
+		{"10.0.0.0/16", "invalid", false},
+	}
+
+	for _, tt := range tests {
+		if got := cidrContains(tt.cidr, tt.ip); got != tt.want {
+			t.Errorf("cidrContains(%q, %q) = %v, want %v", tt.cidr, tt.ip, got, tt.want)
+		}
+	}
+}
+
+func TestSecurityGroupHop(t *testing.T) {
+	peer := &flowEndpoint{Name: "sbcntr-ingress", GroupID: "sg-peer"}
+
+	tests := []struct {
+		name   string
+		rules  []map[string]interface{}
+		peerIP string
+		port   int
+		egress bool
+		want   bool
+	}{
+		{
+			name:   "allowed by group id",
+			rules:  []map[string]interface{}{sgRule("tcp", 80, 80, []string{"sg-peer"}, nil, false)},
+			peerIP: "10.0.1.5",
+			port:   80,
+			want:   true,
+		},
+		{
+			name:   "other group id",
+			rules:  []map[string]interface{}{sgRule("tcp", 80, 80, []string{"sg-other"}, nil, false)},
+			peerIP: "10.0.1.5",
+			port:   80,
+			want:   false,
+		},
+		{
+			name:   "allowed by cidr",
+			rules:  []map[string]interface{}{sgRule("tcp", 80, 80, nil, []string{"10.0.0.0/16"}, false)},
+			peerIP: "10.0.1.5",
+			port:   80,
+			want:   true,
+		},
+		{
+			name:   "cidr does not contain peer",
+			rules:  []map[string]interface{}{sgRule("tcp", 80, 80, nil, []string{"10.1.0.0/16"}, false)},
+			peerIP: "10.0.1.5",
+			port:   80,
+			want:   false,
+		},
+		{
+			name:   "port out of range",
+			rules:  []map[string]interface{}{sgRule("tcp", 80, 80, []string{"sg-peer"}, nil, false)},
+			peerIP: "10.0.1.5",
+			port:   443,
+			want:   false,
+		},
+		{
+			name:   "protocol mismatch",
+			rules:  []map[string]interface{}{sgRule("udp", 80, 80, []string{"sg-peer"}, nil, false)},
+			peerIP: "10.0.1.5",
+			port:   80,
+			want:   false,
+		},
+		{
+			name:   "all protocols",
+			rules:  []map[string]interface{}{sgRule("-1", -1, -1, nil, []string{"0.0.0.0/0"}, true)},
+			peerIP: "10.0.1.5",
+			port:   5432,
+			egress: true,
+			want:   true,
+		},
+		{
+			name:   "egress evaluates destination groups",
+			rules:  []map[string]interface{}{sgRule("tcp", 80, 80, []string{"sg-peer"}, nil, true)},
+			peerIP: "10.0.1.5",
+			port:   80,
+			egress: true,
+			want:   true,
+		},
+		{
+			name:  "unknown ip may match cidr",
+			rules: []map[string]interface{}{sgRule("tcp", 80, 80, nil, []string{"10.0.0.0/16"}, false)},
+			port:  80,
+			want:  true,
+		},
+		{
+			name:  "unknown ip and no matching port",
+			rules: []map[string]interface{}{sgRule("tcp", 80, 80, nil, []string{"10.0.0.0/16"}, false)},
+			port:  443,
+			want:  false,
+		},
+		{
+			name: "no rules",
+			port: 80,
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			endpoint := &flowEndpoint{Name: "sbcntr-frontend-app", GroupID: "sg-self"}
+			if tt.egress {
+				endpoint.EgressRules = tt.rules
+			} else {
+				endpoint.IngressRules = tt.rules
+			}
+			hop := securityGroupHop(endpoint, peer, tt.peerIP, "tcp", tt.port, tt.egress)
+			if hop.Allowed != tt.want {
+				t.Errorf("securityGroupHop() allowed = %v, want %v (%s)", hop.Allowed, tt.want, hop.Detail)
+			}
+		})
+	}
+}
+
+func TestNetworkAclHop(t *testing.T) {
+	tests := []struct {
+		name      string
+		acl       *ec2types.NetworkAcl
+		direction string
+		port      int
+		peerIP    string
+		want      bool
+	}{
+		{
+			name: "allowed",
+			acl: networkAcl(
+				aclEntry(100, false, "6", 80, 80, "10.0.0.0/16", ec2types.RuleActionAllow),
+			),
+			direction: "inbound",
+			port:      80,
+			peerIP:    "10.0.1.5",
+			want:      true,
+		},
+		{
+			name: "deny before allow",
+			acl: networkAcl(
+				aclEntry(200, false, "6", 80, 80, "10.0.0.0/16", ec2types.RuleActionAllow),
+				aclEntry(100, false, "6", 80, 80, "10.0.1.0/24", ec2types.RuleActionDeny),
+			),
+			direction: "inbound",
+			port:      80,
+			peerIP:    "10.0.1.5",
+			want:      false,
+		},
+		{
+			name: "allow before deny",
+			acl: networkAcl(
+				aclEntry(200, false, "-1", 0, 0, "0.0.0.0/0", ec2types.RuleActionDeny),
+				aclEntry(100, false, "6", 80, 80, "10.0.1.0/24", ec2types.RuleActionAllow),
+			),
+			direction: "inbound",
+			port:      80,
+			peerIP:    "10.0.1.5",
+			want:      true,
+		},
+		{
+			name: "outbound ignores inbound entries",
+			acl: networkAcl(
+				aclEntry(100, false, "-1", 0, 0, "0.0.0.0/0", ec2types.RuleActionAllow),
+			),
+			direction: "outbound",
+			port:      80,
+			peerIP:    "10.0.1.5",
+			want:      false,
+		},
+		{
+			name: "return traffic allowed by ephemeral ports",
+			acl: networkAcl(
+				aclEntry(100, true, "6", 1024, 65535, "10.0.0.0/16", ec2types.RuleActionAllow),
+			),
+			direction: "return-outbound",
+			port:      returnTrafficPort,
+			peerIP:    "10.0.1.5",
+			want:      true,
+		},
+		{
+			name: "return traffic blocked",
+			acl: networkAcl(
+				aclEntry(100, true, "6", 80, 80, "10.0.0.0/16", ec2types.RuleActionAllow),
+				aclEntry(32767, true, "-1", 0, 0, "0.0.0.0/0", ec2types.RuleActionDeny),
+			),
+			direction: "return-outbound",
+			port:      returnTrafficPort,
+			peerIP:    "10.0.1.5",
+			want:      false,
+		},
+		{
+			name:      "no matching entry",
+			acl:       networkAcl(),
+			direction: "inbound",
+			port:      80,
+			peerIP:    "10.0.1.5",
+			want:      false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hop := networkAclHop(tt.acl, "subnet-1", tt.direction, "tcp", tt.port, tt.peerIP)
+			if hop.Allowed != tt.want {
+				t.Errorf("networkAclHop() allowed = %v, want %v (%s)", hop.Allowed, tt.want, hop.Detail)
+			}
+		})
+	}
+}
+
+func TestRouteHop(t *testing.T) {
+	tests := []struct {
+		name          string
+		routeTable    *ec2types.RouteTable
+		destinationIP string
+		wantAllowed   bool
+		wantTarget    string
+	}{
+		{
+			name:          "local route",
+			routeTable:    localRouteTable(),
+			destinationIP: "10.0.1.5",
+			wantAllowed:   true,
+			wantTarget:    "local",
+		},
+		{
+			name: "longest prefix wins",
+			routeTable: routeTable(
+				route("0.0.0.0/0", "igw-1", ec2types.RouteStateActive),
+				route("10.0.0.0/16", "local", ec2types.RouteStateActive),
+				route("10.0.1.0/24", "pcx-1", ec2types.RouteStateActive),
+			),
+			destinationIP: "10.0.1.5",
+			wantAllowed:   true,
+			wantTarget:    "pcx-1",
+		},
+		{
+			name: "longest prefix is blackhole",
+			routeTable: routeTable(
+				route("10.0.0.0/16", "local", ec2types.RouteStateActive),
+				route("10.0.1.0/24", "pcx-1", ec2types.RouteStateBlackhole),
+			),
+			destinationIP: "10.0.1.5",
+			wantAllowed:   false,
+		},
+		{
+			name:          "missing route",
+			routeTable:    localRouteTable(),
+			destinationIP: "192.168.0.1",
+			wantAllowed:   false,
+		},
+		{
+			name:          "unknown destination",
+			routeTable:    localRouteTable(),
+			destinationIP: "",
+			wantAllowed:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hop := routeHop(tt.routeTable, "subnet-1", tt.destinationIP)
+			if hop.Allowed != tt.wantAllowed {
+				t.Fatalf("routeHop() allowed = %v, want %v (%s)", hop.Allowed, tt.wantAllowed, hop.Detail)
+			}
+			if tt.wantTarget != "" && !strings.Contains(hop.Detail, tt.wantTarget) {
+				t.Errorf("routeHop() detail = %q, want target %q", hop.Detail, tt.wantTarget)
+			}
+		})
+	}
+}
+
+func TestEvaluatePath(t *testing.T) {
+	src := &flowEndpoint{
+		Name:        "sbcntr-ingress",
+		GroupID:     "sg-src",
+		EgressRules: []map[string]interface{}{sgRule("-1", -1, -1, nil, []string{"0.0.0.0/0"}, true)},
+		SubnetIPs:   map[string]string{"subnet-a": "10.0.0.10", "subnet-c": "10.0.0.20"},
+	}
+	dst := &flowEndpoint{
+		Name:         "sbcntr-frontend-app",
+		GroupID:      "sg-dst",
+		IngressRules: []map[string]interface{}{sgRule("tcp", 80, 80, []string{"sg-src"}, nil, false)},
+		SubnetIPs:    map[string]string{"subnet-b": "10.0.1.10", "subnet-c": "10.0.0.30"},
+	}
+
+	denyAcl := networkAcl(
+		aclEntry(100, false, "-1", 0, 0, "0.0.0.0/0", ec2types.RuleActionDeny),
+		aclEntry(100, true, "-1", 0, 0, "0.0.0.0/0", ec2types.RuleActionDeny),
+	)
+	noReturnAcl := networkAcl(
+		aclEntry(100, false, "-1", 0, 0, "0.0.0.0/0", ec2types.RuleActionAllow),
+		aclEntry(100, true, "6", 80, 80, "0.0.0.0/0", ec2types.RuleActionAllow),
+	)
+
+	tests := []struct {
+		name         string
+		srcSubnet    string
+		dstSubnet    string
+		subnets      map[string]*subnetNetwork
+		wantBlocking string
+	}{
+		{
+			name:      "cross subnet allowed",
+			srcSubnet: "subnet-a",
+			dstSubnet: "subnet-b",
+			subnets: map[string]*subnetNetwork{
+				"subnet-a": {NetworkAcl: allowAllAcl(), RouteTable: localRouteTable()},
+				"subnet-b": {NetworkAcl: allowAllAcl(), RouteTable: localRouteTable()},
+			},
+		},
+		{
+			// 同じサブネット内の通信にはNACLは適用されない
+			name:      "same subnet ignores network acl",
+			srcSubnet: "subnet-c",
+			dstSubnet: "subnet-c",
+			subnets: map[string]*subnetNetwork{
+				"subnet-c": {NetworkAcl: denyAcl, RouteTable: localRouteTable()},
+			},
+		},
+		{
+			name:      "destination network acl denies inbound",
+			srcSubnet: "subnet-a",
+			dstSubnet: "subnet-b",
+			subnets: map[string]*subnetNetwork{
+				"subnet-a": {NetworkAcl: allowAllAcl(), RouteTable: localRouteTable()},
+				"subnet-b": {NetworkAcl: denyAcl, RouteTable: localRouteTable()},
+			},
+			wantBlocking: "network-acl-inbound acl-1 (subnet-b)",
+		},
+		{
+			name:      "return traffic blocked",
+			srcSubnet: "subnet-a",
+			dstSubnet: "subnet-b",
+			subnets: map[string]*subnetNetwork{
+				"subnet-a": {NetworkAcl: allowAllAcl(), RouteTable: localRouteTable()},
+				"subnet-b": {NetworkAcl: noReturnAcl, RouteTable: localRouteTable()},
+			},
+			wantBlocking: "network-acl-return-outbound acl-1 (subnet-b)",
+		},
+		{
+			name:      "missing route",
+			srcSubnet: "subnet-a",
+			dstSubnet: "subnet-b",
+			subnets: map[string]*subnetNetwork{
+				"subnet-a": {NetworkAcl: allowAllAcl(), RouteTable: routeTable(route("10.0.0.0/24", "local", ec2types.RouteStateActive))},
+				"subnet-b": {NetworkAcl: allowAllAcl(), RouteTable: localRouteTable()},
+			},
+			wantBlocking: "route-table rtb-1 (subnet-a)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hops := evaluatePath("tcp", 80, src, dst, tt.srcSubnet, tt.dstSubnet, tt.subnets)
+			result := finishFlow(FlowResult{}, hops)
+			if result.BlockingHop != tt.wantBlocking {
+				t.Errorf("evaluatePath() blocking hop = %q, want %q (%s)", result.BlockingHop, tt.wantBlocking, result.Reason)
+			}
+			if result.Reachable != (tt.wantBlocking == "") {
+				t.Errorf("evaluatePath() reachable = %v, want %v", result.Reachable, tt.wantBlocking == "")
+			}
+		})
+	}
+}
+
+func TestEvaluateFlow(t *testing.T) {
+	subnets := map[string]*subnetNetwork{
+		"subnet-a": {NetworkAcl: allowAllAcl(), RouteTable: localRouteTable()},
+		"subnet-b": {NetworkAcl: allowAllAcl(), RouteTable: localRouteTable()},
+	}
+	allowAllEgress := []map[string]interface{}{sgRule("-1", -1, -1, nil, []string{"0.0.0.0/0"}, true)}
+
+	tests := []struct {
+		name              string
+		dstIngress        []map[string]interface{}
+		srcSubnetIPs      map[string]string
+		dstSubnetIPs      map[string]string
+		wantReachable     bool
+		wantIndeterminate bool
+		wantBlocking      string
+	}{
+		{
+			name:          "referenced by group id",
+			dstIngress:    []map[string]interface{}{sgRule("tcp", 80, 80, []string{"sg-src"}, nil, false)},
+			srcSubnetIPs:  map[string]string{"subnet-a": "10.0.0.10"},
+			dstSubnetIPs:  map[string]string{"subnet-b": "10.0.1.10"},
+			wantReachable: true,
+		},
+		{
+			name:          "referenced by cidr",
+			dstIngress:    []map[string]interface{}{sgRule("tcp", 80, 80, nil, []string{"10.0.0.0/24"}, false)},
+			srcSubnetIPs:  map[string]string{"subnet-a": "10.0.0.10"},
+			dstSubnetIPs:  map[string]string{"subnet-b": "10.0.1.10"},
+			wantReachable: true,
+		},
+		{
+			name:         "cidr does not contain one of the source subnets",
+			dstIngress:   []map[string]interface{}{sgRule("tcp", 80, 80, nil, []string{"10.0.0.0/24"}, false)},
+			srcSubnetIPs: map[string]string{"subnet-a": "10.0.0.10", "subnet-b": "10.0.1.20"},
+			dstSubnetIPs: map[string]string{"subnet-b": "10.0.1.10"},
+			wantBlocking: "security-group-ingress sbcntr-frontend-app",
+		},
+		{
+			name:         "no interfaces and no matching rule",
+			dstIngress:   []map[string]interface{}{sgRule("tcp", 443, 443, []string{"sg-src"}, nil, false)},
+			srcSubnetIPs: map[string]string{},
+			dstSubnetIPs: map[string]string{},
+			wantBlocking: "security-group-ingress sbcntr-frontend-app",
+		},
+		{
+			name:              "no interfaces and referenced by group id",
+			dstIngress:        []map[string]interface{}{sgRule("tcp", 80, 80, []string{"sg-src"}, nil, false)},
+			srcSubnetIPs:      map[string]string{},
+			dstSubnetIPs:      map[string]string{"subnet-b": "10.0.1.10"},
+			wantIndeterminate: true,
+		},
+		{
+			// IPが分からないためCIDRのルールに一致するかは判定できない
+			name:              "no interfaces and referenced by cidr",
+			dstIngress:        []map[string]interface{}{sgRule("tcp", 80, 80, nil, []string{"10.0.0.0/24"}, false)},
+			srcSubnetIPs:      map[string]string{},
+			dstSubnetIPs:      map[string]string{},
+			wantIndeterminate: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src := &flowEndpoint{Name: "sbcntr-ingress", GroupID: "sg-src", EgressRules: allowAllEgress, SubnetIPs: tt.srcSubnetIPs}
+			dst := &flowEndpoint{Name: "sbcntr-frontend-app", GroupID: "sg-dst", IngressRules: tt.dstIngress, SubnetIPs: tt.dstSubnetIPs}
+
+			result := evaluateFlow(FlowResult{Protocol: "tcp", Port: 80}, src, dst, subnets)
+			if result.Reachable != tt.wantReachable {
+				t.Errorf("evaluateFlow() reachable = %v, want %v (%s)", result.Reachable, tt.wantReachable, result.Reason)
+			}
+			if result.Indeterminate != tt.wantIndeterminate {
+				t.Errorf("evaluateFlow() indeterminate = %v, want %v", result.Indeterminate, tt.wantIndeterminate)
+			}
+			if result.BlockingHop != tt.wantBlocking {
+				t.Errorf("evaluateFlow() blocking hop = %q, want %q", result.BlockingHop, tt.wantBlocking)
+			}
+		})
+	}
+}
@@ -3,22 +3,35 @@ package validator
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"net/url"
 	"reflect"
 	"regexp"
 	"sbcntr2-test-tool/internal/aws"
 	"sbcntr2-test-tool/internal/config"
+	"sbcntr2-test-tool/internal/i18n"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	awsutil "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	logstypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	ecrtypes "github.com/aws/aws-sdk-go-v2/service/ecr/types"
 	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	ecstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
 	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	rdstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
 )
 
 type ResourceValidator struct {
@@ -33,7 +46,10 @@ func NewResourceValidator(awsClient *aws.Client, configManager *config.Manager)
 	}
 }
 
-func (v *ResourceValidator) CheckResourceExists(ctx context.Context, resourceType, resourceName string) (bool, map[string]interface{}, error) {
+func (v *ResourceValidator) CheckResourceExists(ctx context.Context, resource config.ResourceDefinition) (bool, map[string]interface{}, error) {
+	resourceType := resource.Type
+	resourceName := resource.Name
+
 	switch resourceType {
 	case "AWS::EC2::VPC":
 		return v.checkVPC(ctx, resourceName)
@@ -43,6 +59,10 @@ func (v *ResourceValidator) CheckResourceExists(ctx context.Context, resourceTyp
 		return v.checkSecurityGroup(ctx, resourceName)
 	case "AWS::EC2::InternetGateway":
 		return v.checkInternetGateway(ctx, resourceName)
+	case "AWS::EC2::NatGateway":
+		return v.checkNatGateway(ctx, resource.Name)
+	case "AWS::EC2::EIP":
+		return v.checkEIP(ctx, resource.Name)
 	case "AWS::EC2::VPCEndpoint":
 		return v.checkVPCEndpoint(ctx, resourceName)
 	case "AWS::ECR::Repository":
@@ -52,11 +72,13 @@ func (v *ResourceValidator) CheckResourceExists(ctx context.Context, resourceTyp
 	case "AWS::ECS::TaskDefinition":
 		return v.checkTaskDefinition(ctx, resourceName)
 	case "AWS::ECS::Service":
-		return v.checkECSService(ctx, resourceName)
+		return v.checkECSService(ctx, resourceName, resource.Cluster)
 	case "AWS::ElasticLoadBalancingV2::LoadBalancer":
 		return v.checkLoadBalancer(ctx, resourceName)
 	case "AWS::ElasticLoadBalancingV2::TargetGroup":
 		return v.checkTargetGroup(ctx, resourceName)
+	case "AWS::ElasticLoadBalancingV2::Listener":
+		return v.checkListener(ctx, resourceName)
 	case "AWS::RDS::DBCluster":
 		return v.checkDBCluster(ctx, resourceName)
 	case "AWS::RDS::DBInstance":
@@ -65,8 +87,14 @@ func (v *ResourceValidator) CheckResourceExists(ctx context.Context, resourceTyp
 		return v.checkDBSubnetGroup(ctx, resourceName)
 	case "AWS::IAM::Role":
 		return v.checkIAMRole(ctx, resourceName)
+	case "AWS::SecretsManager::Secret":
+		return v.checkSecret(ctx, resourceName)
+	case "AWS::SSM::Parameter":
+		return v.checkSSMParameter(ctx, resourceName)
+	case "AWS::Logs::LogGroup":
+		return v.checkLogGroup(ctx, resourceName)
 	default:
-		return v.checkCloudControlResource(ctx, resourceType, resourceName)
+		return v.checkCloudControlResource(ctx, resourceType, resourceName, resource.Identifier)
 	}
 }
 
@@ -75,7 +103,7 @@ func (v *ResourceValidator) ValidateRule(actualProps map[string]interface{}, rul
 	actualValue, exists := v.getNestedProperty(actualProps, rule.Property)
 
 	if !exists && rule.Type == "exists" {
-		return fmt.Errorf("%s: property '%s' not found", rule.ErrorMessage, rule.Property)
+		return i18n.Errorf("rule.property_not_found", rule.ErrorMessage, rule.Property)
 	}
 
 	switch rule.Type {
@@ -83,10 +111,12 @@ func (v *ResourceValidator) ValidateRule(actualProps map[string]interface{}, rul
 		return v.validateProperty(actualValue, rule)
 	case "exists":
 		if !exists {
-			return fmt.Errorf(rule.ErrorMessage)
+			return errors.New(rule.ErrorMessage.String())
 		}
 	case "count":
 		return v.validateCount(actualValue, rule)
+	case "allows":
+		return v.validateAllows(actualProps, rule)
 	}
 
 	return nil
@@ -129,14 +159,14 @@ func (v *ResourceValidator) getNestedProperty(props map[string]interface{}, path
 							for _, item := range a {
 								if itemMap, ok := item.(map[string]interface{}); ok {
 									if val, exists := v.getNestedProperty(itemMap, remainingPath); exists {
-										results = append(results, val)
+										results = appendWildcardResult(results, val, remainingPath)
 									}
 								}
 							}
 						case []map[string]interface{}:
 							for _, item := range a {
 								if val, exists := v.getNestedProperty(item, remainingPath); exists {
-									results = append(results, val)
+									results = appendWildcardResult(results, val, remainingPath)
 								}
 							}
 						}
@@ -212,6 +242,32 @@ func (v *ResourceValidator) getNestedProperty(props map[string]interface{}, path
 	return current, true
 }
 
+// appendWildcardResult は[*]で抽出した値を結果に追加する
+// 残りのパスにも[*]が含まれる場合は入れ子の配列を平坦化する
+// 例: "ContainerDefinitions[*].PortMappings[*].ContainerPort" -> [80, 443]
+func appendWildcardResult(results []interface{}, val interface{}, remainingPath string) []interface{} {
+	if !strings.Contains(remainingPath, "[*]") {
+		return append(results, val)
+	}
+
+	switch nested := val.(type) {
+	case []interface{}:
+		return append(results, nested...)
+	case []map[string]interface{}:
+		for _, item := range nested {
+			results = append(results, item)
+		}
+		return results
+	case []string:
+		for _, item := range nested {
+			results = append(results, item)
+		}
+		return results
+	default:
+		return append(results, val)
+	}
+}
+
 func (v *ResourceValidator) validateProperty(actual interface{}, rule config.ValidationRule) error {
 	switch rule.Operator {
 	case "eq":
@@ -219,7 +275,7 @@ func (v *ResourceValidator) validateProperty(actual interface{}, rule config.Val
 		if actualNum, ok := toFloat64(actual); ok {
 			if expectedNum, ok := toFloat64(rule.Expected); ok {
 				if actualNum != expectedNum {
-					return fmt.Errorf("%s: expected %v, got %v", rule.ErrorMessage, rule.Expected, actual)
+					return i18n.Errorf("rule.expected", rule.ErrorMessage, rule.Expected, actual)
 				}
 				return nil
 			}
@@ -227,41 +283,41 @@ func (v *ResourceValidator) validateProperty(actual interface{}, rule config.Val
 
 		// それ以外の場合は通常の比較
 		if !reflect.DeepEqual(actual, rule.Expected) {
-			return fmt.Errorf("%s: expected %v, got %v", rule.ErrorMessage, rule.Expected, actual)
+			return i18n.Errorf("rule.expected", rule.ErrorMessage, rule.Expected, actual)
 		}
 	case "ne":
 		if reflect.DeepEqual(actual, rule.Expected) {
-			return fmt.Errorf("%s: value should not be %v", rule.ErrorMessage, rule.Expected)
+			return i18n.Errorf("rule.ne", rule.ErrorMessage, rule.Expected)
 		}
 	case "gt":
 		if !v.compareNumbers(actual, rule.Expected, ">") {
-			return fmt.Errorf("%s: %v should be greater than %v", rule.ErrorMessage, actual, rule.Expected)
+			return i18n.Errorf("rule.gt", rule.ErrorMessage, actual, rule.Expected)
 		}
 	case "lt":
 		if !v.compareNumbers(actual, rule.Expected, "<") {
-			return fmt.Errorf("%s: %v should be less than %v", rule.ErrorMessage, actual, rule.Expected)
+			return i18n.Errorf("rule.lt", rule.ErrorMessage, actual, rule.Expected)
 		}
 	case "ge":
 		if !v.compareNumbers(actual, rule.Expected, ">=") {
-			return fmt.Errorf("%s: %v should be greater than or equal to %v", rule.ErrorMessage, actual, rule.Expected)
+			return i18n.Errorf("rule.ge", rule.ErrorMessage, actual, rule.Expected)
 		}
 	case "le":
 		if !v.compareNumbers(actual, rule.Expected, "<=") {
-			return fmt.Errorf("%s: %v should be less than or equal to %v", rule.ErrorMessage, actual, rule.Expected)
+			return i18n.Errorf("rule.le", rule.ErrorMessage, actual, rule.Expected)
 		}
 	case "contains":
 		if !v.contains(actual, rule.Expected) {
-			return fmt.Errorf("%s: %v should contain %v", rule.ErrorMessage, actual, rule.Expected)
+			return i18n.Errorf("rule.contains", rule.ErrorMessage, actual, rule.Expected)
 		}
 	case "regex":
 		if !v.matchRegex(actual, rule.Expected) {
-			return fmt.Errorf("%s: %v does not match pattern %v", rule.ErrorMessage, actual, rule.Expected)
+			return i18n.Errorf("rule.regex", rule.ErrorMessage, actual, rule.Expected)
 		}
 	case "starts_with":
 		actualStr := fmt.Sprintf("%v", actual)
 		expectedStr := fmt.Sprintf("%v", rule.Expected)
 		if !strings.HasPrefix(actualStr, expectedStr) {
-			return fmt.Errorf("%s: %v should start with %v", rule.ErrorMessage, actual, rule.Expected)
+			return i18n.Errorf("rule.starts_with", rule.ErrorMessage, actual, rule.Expected)
 		}
 	}
 
@@ -277,6 +333,8 @@ func (v *ResourceValidator) validateCount(actual interface{}, rule config.Valida
 		count = len(val)
 	case []string:
 		count = len(val)
+	case []map[string]interface{}:
+		count = len(val)
 	default:
 		return fmt.Errorf("cannot count non-collection type")
 	}
@@ -295,27 +353,27 @@ func (v *ResourceValidator) validateCount(actual interface{}, rule config.Valida
 	switch operator {
 	case "eq":
 		if count != expected {
-			return fmt.Errorf("%s: expected count %d, got %d", rule.ErrorMessage, expected, count)
+			return i18n.Errorf("rule.count_expected", rule.ErrorMessage, expected, count)
 		}
 	case "ne":
 		if count == expected {
-			return fmt.Errorf("%s: count should not be %d", rule.ErrorMessage, expected)
+			return i18n.Errorf("rule.count_ne", rule.ErrorMessage, expected)
 		}
 	case "gt":
 		if count <= expected {
-			return fmt.Errorf("%s: count %d should be greater than %d", rule.ErrorMessage, count, expected)
+			return i18n.Errorf("rule.count_gt", rule.ErrorMessage, count, expected)
 		}
 	case "lt":
 		if count >= expected {
-			return fmt.Errorf("%s: count %d should be less than %d", rule.ErrorMessage, count, expected)
+			return i18n.Errorf("rule.count_lt", rule.ErrorMessage, count, expected)
 		}
 	case "ge":
 		if count < expected {
-			return fmt.Errorf("%s: count %d should be greater than or equal to %d", rule.ErrorMessage, count, expected)
+			return i18n.Errorf("rule.count_ge", rule.ErrorMessage, count, expected)
 		}
 	case "le":
 		if count > expected {
-			return fmt.Errorf("%s: count %d should be less than or equal to %d", rule.ErrorMessage, count, expected)
+			return i18n.Errorf("rule.count_le", rule.ErrorMessage, count, expected)
 		}
 	default:
 		return fmt.Errorf("unsupported operator for count: %s", operator)
@@ -499,6 +557,8 @@ func (v *ResourceValidator) checkSubnet(ctx context.Context, subnetName string)
 		"VpcId":            *subnet.VpcId,
 	}
 
+	v.addEgressPath(ctx, *subnet.SubnetId, *subnet.VpcId, props)
+
 	return true, props, nil
 }
 
@@ -528,19 +588,40 @@ func (v *ResourceValidator) checkSecurityGroup(ctx context.Context, sgName strin
 		"VpcId":     *sg.VpcId,
 	}
 
-	var ingressRules []map[string]interface{}
-	for _, rule := range sg.IpPermissions {
-		ingressRule := map[string]interface{}{
-			"IpProtocol": rule.IpProtocol,
+	ingressRules := v.normalizeSecurityGroupRules(ctx, sg.IpPermissions, "Source", awsutil.ToString(sg.OwnerId))
+	props["IngressRules"] = ingressRules
+	props["EgressRules"] = v.normalizeSecurityGroupRules(ctx, sg.IpPermissionsEgress, "Destination", awsutil.ToString(sg.OwnerId))
+	props["OwnerId"] = awsutil.ToString(sg.OwnerId)
+	props["Description"] = awsutil.ToString(sg.Description)
+	addSecurityGroupExposure(props, ingressRules)
+
+	return true, props, nil
+}
+
+// normalizeSecurityGroupRules はセキュリティグループのルールをmapに変換する
+// peerPrefixはインバウンドなら"Source"、アウトバウンドなら"Destination"を指定し、
+// 参照先のセキュリティグループを <peerPrefix>SecurityGroups / <peerPrefix>SecurityGroupNames に格納する
+// ownerIDと異なるアカウントのセキュリティグループを参照している場合はCrossAccountをtrueにする
+func (v *ResourceValidator) normalizeSecurityGroupRules(ctx context.Context, permissions []ec2types.IpPermission, peerPrefix, ownerID string) []map[string]interface{} {
+	rules := []map[string]interface{}{}
+	for _, rule := range permissions {
+		protocol := awsutil.ToString(rule.IpProtocol)
+		normalized := map[string]interface{}{
+			"IpProtocol": protocol,
 		}
 
 		// ポートをデリファレンスして格納
 		if rule.FromPort != nil {
-			ingressRule["FromPort"] = *rule.FromPort
+			normalized["FromPort"] = *rule.FromPort
 		}
 		if rule.ToPort != nil {
-			ingressRule["ToPort"] = *rule.ToPort
+			normalized["ToPort"] = *rule.ToPort
 		}
+		normalized["AllPorts"] = protocol == "-1" ||
+			(awsutil.ToInt32(rule.FromPort) == 0 && awsutil.ToInt32(rule.ToPort) == 65535)
+
+		var descriptions []string
+		openToWorld := false
 
 		// CIDRブロックを追加
 		if len(rule.IpRanges) > 0 {
@@ -548,39 +629,130 @@ func (v *ResourceValidator) checkSecurityGroup(ctx context.Context, sgName strin
 			for _, ipRange := range rule.IpRanges {
 				if ipRange.CidrIp != nil {
 					cidrs = append(cidrs, *ipRange.CidrIp)
+					if *ipRange.CidrIp == "0.0.0.0/0" {
+						openToWorld = true
+					}
+				}
+				if ipRange.Description != nil {
+					descriptions = append(descriptions, *ipRange.Description)
+				}
+			}
+			normalized["CidrBlocks"] = cidrs
+		}
+
+		if len(rule.Ipv6Ranges) > 0 {
+			var cidrs []string
+			for _, ipRange := range rule.Ipv6Ranges {
+				if ipRange.CidrIpv6 != nil {
+					cidrs = append(cidrs, *ipRange.CidrIpv6)
+					if *ipRange.CidrIpv6 == "::/0" {
+						openToWorld = true
+					}
+				}
+				if ipRange.Description != nil {
+					descriptions = append(descriptions, *ipRange.Description)
+				}
+			}
+			normalized["Ipv6CidrBlocks"] = cidrs
+		}
+
+		if len(rule.PrefixListIds) > 0 {
+			var prefixLists []string
+			for _, prefixList := range rule.PrefixListIds {
+				if prefixList.PrefixListId != nil {
+					prefixLists = append(prefixLists, *prefixList.PrefixListId)
+				}
+				if prefixList.Description != nil {
+					descriptions = append(descriptions, *prefixList.Description)
 				}
 			}
-			ingressRule["CidrBlocks"] = cidrs
+			normalized["PrefixListIds"] = prefixLists
 		}
 
-		// ソースセキュリティグループを追加
+		// 参照先のセキュリティグループを追加
 		if len(rule.UserIdGroupPairs) > 0 {
-			var sourceGroups []string
-			var sourceGroupNames []string
+			var groups []string
+			var groupNames []string
+			pairs := []map[string]interface{}{}
 
 			for _, group := range rule.UserIdGroupPairs {
+				userID := awsutil.ToString(group.UserId)
+				pair := map[string]interface{}{
+					"GroupId":       awsutil.ToString(group.GroupId),
+					"UserId":        userID,
+					"VpcId":         awsutil.ToString(group.VpcId),
+					"PeeringStatus": awsutil.ToString(group.PeeringStatus),
+					"CrossAccount":  userID != "" && ownerID != "" && userID != ownerID,
+				}
+
 				if group.GroupId != nil {
-					sourceGroups = append(sourceGroups, *group.GroupId)
+					groups = append(groups, *group.GroupId)
 
-					// セキュリティグループIDからNameタグを取得
+					// セキュリティグループIDからNameタグを取得（他アカウントのものは取得できない）
 					sgName, err := v.getSecurityGroupName(ctx, *group.GroupId)
 					if err == nil && sgName != "" {
-						sourceGroupNames = append(sourceGroupNames, sgName)
+						groupNames = append(groupNames, sgName)
+						pair["GroupName"] = sgName
 					}
 				}
+				if group.Description != nil {
+					descriptions = append(descriptions, *group.Description)
+					pair["Description"] = *group.Description
+				}
+				pairs = append(pairs, pair)
 			}
-			ingressRule["SourceSecurityGroups"] = sourceGroups
-			if len(sourceGroupNames) > 0 {
-				ingressRule["SourceSecurityGroupNames"] = sourceGroupNames
+			normalized[peerPrefix+"SecurityGroups"] = groups
+			if len(groupNames) > 0 {
+				normalized[peerPrefix+"SecurityGroupNames"] = groupNames
 			}
+			normalized[peerPrefix+"SecurityGroupPairs"] = pairs
 		}
 
-		ingressRules = append(ingressRules, ingressRule)
+		if len(descriptions) > 0 {
+			normalized["Descriptions"] = descriptions
+		}
+		normalized["OpenToWorld"] = openToWorld
+
+		rules = append(rules, normalized)
 	}
+	return rules
+}
 
-	props["IngressRules"] = ingressRules
+// addSecurityGroupExposure はインバウンドルールからインターネットへの公開状況を集計してpropsに追加する
+//
+//	OpenToWorld:        0.0.0.0/0 または ::/0 を許可するインバウンドルールがある
+//	OpenToWorldAnyPort: 上記のうち全ポート（プロトコル-1または0-65535）を許可するルールがある
+//	OpenToWorldPorts:   インターネットに公開しているポート範囲（例: "tcp/80", "all"）
+func addSecurityGroupExposure(props map[string]interface{}, ingressRules []map[string]interface{}) {
+	openToWorld := false
+	anyPort := false
+	ports := []string{}
+
+	for _, rule := range ingressRules {
+		if open, _ := rule["OpenToWorld"].(bool); !open {
+			continue
+		}
+		openToWorld = true
 
-	return true, props, nil
+		if all, _ := rule["AllPorts"].(bool); all {
+			anyPort = true
+			ports = append(ports, "all")
+			continue
+		}
+
+		protocol, _ := rule["IpProtocol"].(string)
+		from, _ := rule["FromPort"].(int32)
+		to, _ := rule["ToPort"].(int32)
+		if from == to {
+			ports = append(ports, fmt.Sprintf("%s/%d", protocol, from))
+		} else {
+			ports = append(ports, fmt.Sprintf("%s/%d-%d", protocol, from, to))
+		}
+	}
+
+	props["OpenToWorld"] = openToWorld
+	props["OpenToWorldAnyPort"] = anyPort
+	props["OpenToWorldPorts"] = ports
 }
 
 // getSecurityGroupName はセキュリティグループIDからNameタグを取得する
@@ -689,6 +861,101 @@ func (v *ResourceValidator) checkInternetGateway(ctx context.Context, igwName st
 	return true, props, nil
 }
 
+func (v *ResourceValidator) checkNatGateway(ctx context.Context, natName string) (bool, map[string]interface{}, error) {
+	input := &ec2.DescribeNatGatewaysInput{
+		Filter: []ec2types.Filter{
+			{
+				Name:   awsutil.String("tag:Name"),
+				Values: []string{natName},
+			},
+		},
+	}
+
+	result, err := v.awsClient.EC2.DescribeNatGateways(ctx, input)
+	if err != nil {
+		return false, nil, nil
+	}
+
+	// 削除済みのNATゲートウェイはしばらく一覧に残るため除外する
+	var natGateway *ec2types.NatGateway
+	for i, nat := range result.NatGateways {
+		if nat.State == ec2types.NatGatewayStateDeleted || nat.State == ec2types.NatGatewayStateDeleting {
+			continue
+		}
+		natGateway = &result.NatGateways[i]
+		break
+	}
+	if natGateway == nil {
+		return false, nil, nil
+	}
+
+	props := map[string]interface{}{
+		"NatGatewayId":     awsutil.ToString(natGateway.NatGatewayId),
+		"State":            string(natGateway.State),
+		"ConnectivityType": string(natGateway.ConnectivityType),
+		"VpcId":            awsutil.ToString(natGateway.VpcId),
+		"SubnetId":         awsutil.ToString(natGateway.SubnetId),
+	}
+
+	if natGateway.SubnetId != nil {
+		if subnetName, err := v.getSubnetName(ctx, *natGateway.SubnetId); err == nil {
+			props["SubnetName"] = subnetName
+		}
+	}
+
+	addresses := []map[string]interface{}{}
+	for _, address := range natGateway.NatGatewayAddresses {
+		addresses = append(addresses, map[string]interface{}{
+			"AllocationId":       awsutil.ToString(address.AllocationId),
+			"PublicIp":           awsutil.ToString(address.PublicIp),
+			"PrivateIp":          awsutil.ToString(address.PrivateIp),
+			"NetworkInterfaceId": awsutil.ToString(address.NetworkInterfaceId),
+			"IsPrimary":          awsutil.ToBool(address.IsPrimary),
+		})
+		if awsutil.ToBool(address.IsPrimary) || len(natGateway.NatGatewayAddresses) == 1 {
+			props["PublicIp"] = awsutil.ToString(address.PublicIp)
+			props["AllocationId"] = awsutil.ToString(address.AllocationId)
+		}
+	}
+	props["NatGatewayAddresses"] = addresses
+
+	return true, props, nil
+}
+
+func (v *ResourceValidator) checkEIP(ctx context.Context, eipName string) (bool, map[string]interface{}, error) {
+	input := &ec2.DescribeAddressesInput{
+		Filters: []ec2types.Filter{
+			{
+				Name:   awsutil.String("tag:Name"),
+				Values: []string{eipName},
+			},
+		},
+	}
+
+	result, err := v.awsClient.EC2.DescribeAddresses(ctx, input)
+	if err != nil {
+		return false, nil, nil
+	}
+
+	if len(result.Addresses) == 0 {
+		return false, nil, nil
+	}
+
+	address := result.Addresses[0]
+	props := map[string]interface{}{
+		"AllocationId":       awsutil.ToString(address.AllocationId),
+		"PublicIp":           awsutil.ToString(address.PublicIp),
+		"Domain":             string(address.Domain),
+		"AssociationId":      awsutil.ToString(address.AssociationId),
+		"Associated":         address.AssociationId != nil,
+		"NetworkInterfaceId": awsutil.ToString(address.NetworkInterfaceId),
+		"PrivateIpAddress":   awsutil.ToString(address.PrivateIpAddress),
+		"InstanceId":         awsutil.ToString(address.InstanceId),
+	}
+
+	return true, props, nil
+}
+
 func (v *ResourceValidator) checkVPCEndpoint(ctx context.Context, endpointName string) (bool, map[string]interface{}, error) {
 	input := &ec2.DescribeVpcEndpointsInput{
 		Filters: []ec2types.Filter{
@@ -807,17 +1074,26 @@ func (v *ResourceValidator) checkECRRepository(ctx context.Context, repoName str
 	// ImageTagMutabilityを追加
 	props["ImageTagMutability"] = string(repo.ImageTagMutability)
 
-	// イメージタグを取得
-	imageInput := &ecr.ListImagesInput{
-		RepositoryName: repo.RepositoryName,
+	// イメージスキャン設定を追加
+	scanOnPush := false
+	if repo.ImageScanningConfiguration != nil {
+		scanOnPush = repo.ImageScanningConfiguration.ScanOnPush
+	}
+	props["ImageScanningConfiguration"] = map[string]interface{}{
+		"ScanOnPush": scanOnPush,
 	}
 
-	// ImageTagsを空配列で初期化
+	// イメージタグを取得（ImageTagsは空配列で初期化）
 	imageTags := []string{}
-
-	imageResult, err := v.awsClient.ECR.ListImages(ctx, imageInput)
-	if err == nil && imageResult != nil {
-		for _, imageId := range imageResult.ImageIds {
+	listPaginator := ecr.NewListImagesPaginator(v.awsClient.ECR, &ecr.ListImagesInput{
+		RepositoryName: repo.RepositoryName,
+	})
+	for listPaginator.HasMorePages() {
+		page, err := listPaginator.NextPage(ctx)
+		if err != nil {
+			break
+		}
+		for _, imageId := range page.ImageIds {
 			if imageId.ImageTag != nil {
 				imageTags = append(imageTags, *imageId.ImageTag)
 			}
@@ -827,9 +1103,96 @@ func (v *ResourceValidator) checkECRRepository(ctx context.Context, repoName str
 	// 常にImageTagsを設定（空配列でも）
 	props["ImageTags"] = imageTags
 
+	// イメージの詳細（ダイジェスト、プッシュ日時、サイズ、スキャン結果）を取得
+	images := []map[string]interface{}{}
+	imagesByTag := map[string]interface{}{}
+	describePaginator := ecr.NewDescribeImagesPaginator(v.awsClient.ECR, &ecr.DescribeImagesInput{
+		RepositoryName: repo.RepositoryName,
+	})
+	for describePaginator.HasMorePages() {
+		page, err := describePaginator.NextPage(ctx)
+		if err != nil {
+			break
+		}
+		for _, detail := range page.ImageDetails {
+			image := normalizeImageDetail(detail)
+			images = append(images, image)
+
+			// タグ名でイメージを参照できるようにする（例: "ImagesByTag.v1.PushedHoursAgo"）
+			for _, tag := range detail.ImageTags {
+				imagesByTag[tag] = image
+			}
+		}
+	}
+	props["Images"] = images
+	props["ImagesByTag"] = imagesByTag
+
+	// ライフサイクルポリシーを取得
+	props["HasLifecyclePolicy"] = false
+	lifecycleResult, err := v.awsClient.ECR.GetLifecyclePolicy(ctx, &ecr.GetLifecyclePolicyInput{
+		RepositoryName: repo.RepositoryName,
+	})
+	if err == nil && lifecycleResult.LifecyclePolicyText != nil {
+		var lifecyclePolicy map[string]interface{}
+		if err := json.Unmarshal([]byte(*lifecycleResult.LifecyclePolicyText), &lifecyclePolicy); err == nil {
+			props["HasLifecyclePolicy"] = true
+			props["LifecyclePolicy"] = lifecyclePolicy
+		}
+	}
+
 	return true, props, nil
 }
 
+// normalizeImageDetail はECRイメージの詳細をmapに変換する
+func normalizeImageDetail(detail ecrtypes.ImageDetail) map[string]interface{} {
+	image := map[string]interface{}{
+		"ImageDigest": awsutil.ToString(detail.ImageDigest),
+		"ImageTags":   detail.ImageTags,
+	}
+	if detail.ImageTags == nil {
+		image["ImageTags"] = []string{}
+	}
+
+	if detail.ImagePushedAt != nil {
+		image["ImagePushedAt"] = detail.ImagePushedAt.Format(time.RFC3339)
+		// 「24時間以内にプッシュされた」のようなルールのために経過時間も追加
+		image["PushedHoursAgo"] = time.Since(*detail.ImagePushedAt).Hours()
+	}
+	if detail.ImageSizeInBytes != nil {
+		image["ImageSizeInBytes"] = *detail.ImageSizeInBytes
+	}
+
+	if detail.ImageScanStatus != nil {
+		image["ImageScanStatus"] = map[string]interface{}{
+			"Status":      string(detail.ImageScanStatus.Status),
+			"Description": awsutil.ToString(detail.ImageScanStatus.Description),
+		}
+	}
+
+	if detail.ImageScanFindingsSummary != nil {
+		// 検出がない重要度も0としてルールから参照できるようにする
+		severityCounts := map[string]interface{}{}
+		for _, severity := range ecrtypes.FindingSeverity("").Values() {
+			severityCounts[string(severity)] = int32(0)
+		}
+		total := 0
+		for severity, count := range detail.ImageScanFindingsSummary.FindingSeverityCounts {
+			severityCounts[severity] = count
+			total += int(count)
+		}
+		summary := map[string]interface{}{
+			"FindingSeverityCounts": severityCounts,
+			"TotalFindings":         total,
+		}
+		if detail.ImageScanFindingsSummary.ImageScanCompletedAt != nil {
+			summary["ImageScanCompletedAt"] = detail.ImageScanFindingsSummary.ImageScanCompletedAt.Format(time.RFC3339)
+		}
+		image["ImageScanFindingsSummary"] = summary
+	}
+
+	return image
+}
+
 func (v *ResourceValidator) checkECSCluster(ctx context.Context, clusterName string) (bool, map[string]interface{}, error) {
 	input := &ecs.DescribeClustersInput{
 		Clusters: []string{clusterName},
@@ -874,9 +1237,60 @@ func (v *ResourceValidator) checkTaskDefinition(ctx context.Context, taskDefName
 		"Status":   string(taskDef.Status),
 	}
 
-	// ContainerDefinitionsを追加
+	if taskDef.TaskDefinitionArn != nil {
+		props["TaskDefinitionArn"] = *taskDef.TaskDefinitionArn
+	}
+
+	// ContainerDefinitionsをルールから参照できる形に正規化して追加
 	if len(taskDef.ContainerDefinitions) > 0 {
-		props["ContainerDefinitions"] = taskDef.ContainerDefinitions
+		var containers []map[string]interface{}
+		allSecretsResolvable := true
+		for _, container := range taskDef.ContainerDefinitions {
+			c := normalizeContainerDefinition(container)
+
+			// secretsの参照先（Secrets Manager / SSM パラメータ）が存在するか突き合わせる
+			if secrets, ok := c["Secrets"].([]map[string]interface{}); ok {
+				for _, secret := range secrets {
+					valueFrom, _ := secret["ValueFrom"].(string)
+					for key, value := range v.resolveSecretReference(ctx, valueFrom) {
+						secret[key] = value
+					}
+					// 参照先を確認できなかった（権限不足等）場合は存在しないとはみなさない
+					if resolvable, _ := secret["Resolvable"].(bool); !resolvable && secret["Error"] == nil {
+						allSecretsResolvable = false
+					}
+				}
+			}
+
+			containers = append(containers, c)
+		}
+		props["ContainerDefinitions"] = containers
+		props["AllSecretsResolvable"] = allSecretsResolvable
+		props["AllLogGroupsExist"] = v.resolveLogGroups(ctx, containers)
+	}
+
+	// 起動タイプの互換性
+	if len(taskDef.RequiresCompatibilities) > 0 {
+		var compatibilities []string
+		for _, compatibility := range taskDef.RequiresCompatibilities {
+			compatibilities = append(compatibilities, string(compatibility))
+		}
+		props["RequiresCompatibilities"] = compatibilities
+	}
+
+	// ランタイムプラットフォーム
+	if taskDef.RuntimePlatform != nil {
+		props["RuntimePlatform"] = map[string]interface{}{
+			"CpuArchitecture":       string(taskDef.RuntimePlatform.CpuArchitecture),
+			"OperatingSystemFamily": string(taskDef.RuntimePlatform.OperatingSystemFamily),
+		}
+	}
+
+	// エフェメラルストレージ
+	if taskDef.EphemeralStorage != nil {
+		props["EphemeralStorage"] = map[string]interface{}{
+			"SizeInGiB": taskDef.EphemeralStorage.SizeInGiB,
+		}
 	}
 
 	// CPUとメモリの設定も追加
@@ -905,13 +1319,133 @@ func (v *ResourceValidator) checkTaskDefinition(ctx context.Context, taskDefName
 	return true, props, nil
 }
 
-func (v *ResourceValidator) checkECSService(ctx context.Context, serviceName string) (bool, map[string]interface{}, error) {
-	clusters, err := v.awsClient.ECS.ListClusters(ctx, &ecs.ListClustersInput{})
-	if err != nil {
-		return false, nil, err
+// normalizeContainerDefinition はSDKのコンテナ定義をgetNestedPropertyで辿れるmapに変換する
+func normalizeContainerDefinition(container ecstypes.ContainerDefinition) map[string]interface{} {
+	c := map[string]interface{}{
+		"Cpu": container.Cpu,
+	}
+
+	if container.Name != nil {
+		c["Name"] = *container.Name
+	}
+	if container.Image != nil {
+		c["Image"] = *container.Image
+	}
+	if container.Essential != nil {
+		c["Essential"] = *container.Essential
+	}
+	if container.Memory != nil {
+		c["Memory"] = *container.Memory
+	}
+	if container.MemoryReservation != nil {
+		c["MemoryReservation"] = *container.MemoryReservation
+	}
+	if container.ReadonlyRootFilesystem != nil {
+		c["ReadonlyRootFilesystem"] = *container.ReadonlyRootFilesystem
+	}
+	if len(container.Command) > 0 {
+		c["Command"] = container.Command
+	}
+	if len(container.EntryPoint) > 0 {
+		c["EntryPoint"] = container.EntryPoint
+	}
+
+	// ポートマッピング
+	portMappings := []map[string]interface{}{}
+	for _, pm := range container.PortMappings {
+		mapping := map[string]interface{}{
+			"Protocol": string(pm.Protocol),
+		}
+		if pm.ContainerPort != nil {
+			mapping["ContainerPort"] = *pm.ContainerPort
+		}
+		if pm.HostPort != nil {
+			mapping["HostPort"] = *pm.HostPort
+		}
+		if pm.Name != nil {
+			mapping["Name"] = *pm.Name
+		}
+		if pm.AppProtocol != "" {
+			mapping["AppProtocol"] = string(pm.AppProtocol)
+		}
+		portMappings = append(portMappings, mapping)
+	}
+	c["PortMappings"] = portMappings
+
+	// 環境変数
+	environment := []map[string]interface{}{}
+	for _, env := range container.Environment {
+		environment = append(environment, map[string]interface{}{
+			"Name":  awsutil.ToString(env.Name),
+			"Value": awsutil.ToString(env.Value),
+		})
+	}
+	c["Environment"] = environment
+
+	// シークレット（値そのものではなく参照先のARNのみ）
+	secrets := []map[string]interface{}{}
+	for _, secret := range container.Secrets {
+		secrets = append(secrets, map[string]interface{}{
+			"Name":      awsutil.ToString(secret.Name),
+			"ValueFrom": awsutil.ToString(secret.ValueFrom),
+		})
+	}
+	c["Secrets"] = secrets
+
+	// ログ設定
+	if container.LogConfiguration != nil {
+		options := map[string]interface{}{}
+		for key, value := range container.LogConfiguration.Options {
+			options[key] = value
+		}
+		c["LogConfiguration"] = map[string]interface{}{
+			"LogDriver": string(container.LogConfiguration.LogDriver),
+			"Options":   options,
+		}
+	}
+
+	// ヘルスチェック
+	if container.HealthCheck != nil {
+		healthCheck := map[string]interface{}{
+			"Command": container.HealthCheck.Command,
+		}
+		if container.HealthCheck.Interval != nil {
+			healthCheck["Interval"] = *container.HealthCheck.Interval
+		}
+		if container.HealthCheck.Timeout != nil {
+			healthCheck["Timeout"] = *container.HealthCheck.Timeout
+		}
+		if container.HealthCheck.Retries != nil {
+			healthCheck["Retries"] = *container.HealthCheck.Retries
+		}
+		if container.HealthCheck.StartPeriod != nil {
+			healthCheck["StartPeriod"] = *container.HealthCheck.StartPeriod
+		}
+		c["HealthCheck"] = healthCheck
+	}
+
+	return c
+}
+
+func (v *ResourceValidator) checkECSService(ctx context.Context, serviceName, clusterName string) (bool, map[string]interface{}, error) {
+	var clusterArns []string
+	if clusterName != "" {
+		clusterArns = []string{clusterName}
+	} else {
+		// クラスターが指定されていない場合はアカウント内の全クラスターを検索する
+		paginator := ecs.NewListClustersPaginator(v.awsClient.ECS, &ecs.ListClustersInput{})
+		for paginator.HasMorePages() {
+			page, err := paginator.NextPage(ctx)
+			if err != nil {
+				return false, nil, err
+			}
+			clusterArns = append(clusterArns, page.ClusterArns...)
+		}
 	}
 
-	for _, clusterArn := range clusters.ClusterArns {
+	var matches []ecstypes.Service
+	var inactive []ecstypes.Service
+	for _, clusterArn := range clusterArns {
 		input := &ecs.DescribeServicesInput{
 			Cluster:  &clusterArn,
 			Services: []string{serviceName},
@@ -919,100 +1453,397 @@ func (v *ResourceValidator) checkECSService(ctx context.Context, serviceName str
 
 		result, err := v.awsClient.ECS.DescribeServices(ctx, input)
 		if err != nil {
+			// 指定されたクラスターでの失敗はNOT_FOUNDにせずエラーとして返す。全クラスターの検索では取得できたクラスターだけで判定する
+			if clusterName != "" {
+				return false, nil, err
+			}
 			continue
 		}
 
-		if len(result.Services) > 0 && result.Services[0].Status != nil {
-			service := result.Services[0]
-			props := map[string]interface{}{
-				"ServiceName": *service.ServiceName,
-				"Status":      *service.Status,
+		for _, service := range result.Services {
+			if service.Status == nil {
+				continue
+			}
+			// 削除済みのサービスはしばらくINACTIVEとして残るため、アクティブなものを優先する
+			if *service.Status == "INACTIVE" {
+				inactive = append(inactive, service)
+				continue
 			}
+			matches = append(matches, service)
+		}
+	}
+
+	if len(matches) > 1 {
+		var clusters []string
+		for _, service := range matches {
+			clusters = append(clusters, awsutil.ToString(service.ClusterArn))
+		}
+		return false, nil, fmt.Errorf("ECS service %s exists in multiple clusters (%s); specify the cluster in the step definition", serviceName, strings.Join(clusters, ", "))
+	}
+
+	if len(matches) == 0 {
+		if len(inactive) == 0 {
+			return false, nil, nil
+		}
+		matches = inactive[:1]
+	}
+
+	service := matches[0]
+	clusterArn := awsutil.ToString(service.ClusterArn)
+	props := map[string]interface{}{
+		"ServiceName": *service.ServiceName,
+		"Status":      *service.Status,
+		"ClusterArn":  clusterArn,
+	}
 
-			props["DesiredCount"] = service.DesiredCount
-			props["RunningCount"] = service.RunningCount
+	props["DesiredCount"] = service.DesiredCount
+	props["RunningCount"] = service.RunningCount
 
-			// ネットワーク構成からセキュリティグループとサブネットを取得
-			if service.NetworkConfiguration != nil &&
-				service.NetworkConfiguration.AwsvpcConfiguration != nil {
-				awsvpcConfig := service.NetworkConfiguration.AwsvpcConfiguration
+	// ネットワーク構成からセキュリティグループとサブネットを取得
+	if service.NetworkConfiguration != nil &&
+		service.NetworkConfiguration.AwsvpcConfiguration != nil {
+		awsvpcConfig := service.NetworkConfiguration.AwsvpcConfiguration
 
-				// セキュリティグループを追加
-				if len(awsvpcConfig.SecurityGroups) > 0 {
-					props["SecurityGroups"] = awsvpcConfig.SecurityGroups
+		// セキュリティグループを追加
+		if len(awsvpcConfig.SecurityGroups) > 0 {
+			props["SecurityGroups"] = awsvpcConfig.SecurityGroups
 
-					// セキュリティグループ名も追加
-					var securityGroupNames []string
-					for _, sgID := range awsvpcConfig.SecurityGroups {
-						sgName, err := v.getSecurityGroupName(ctx, sgID)
-						if err == nil && sgName != "" {
-							securityGroupNames = append(securityGroupNames, sgName)
-						}
-					}
-					if len(securityGroupNames) > 0 {
-						props["SecurityGroupNames"] = securityGroupNames
-					}
+			// セキュリティグループ名も追加
+			var securityGroupNames []string
+			for _, sgID := range awsvpcConfig.SecurityGroups {
+				sgName, err := v.getSecurityGroupName(ctx, sgID)
+				if err == nil && sgName != "" {
+					securityGroupNames = append(securityGroupNames, sgName)
 				}
+			}
+			if len(securityGroupNames) > 0 {
+				props["SecurityGroupNames"] = securityGroupNames
+			}
+		}
 
-				// サブネットを追加
-				if len(awsvpcConfig.Subnets) > 0 {
-					props["Subnets"] = awsvpcConfig.Subnets
+		// サブネットを追加
+		if len(awsvpcConfig.Subnets) > 0 {
+			props["Subnets"] = awsvpcConfig.Subnets
 
-					// サブネット名も追加
-					var subnetNames []string
-					for _, subnetID := range awsvpcConfig.Subnets {
-						subnetName, err := v.getSubnetName(ctx, subnetID)
-						if err == nil && subnetName != "" {
-							subnetNames = append(subnetNames, subnetName)
-						}
-					}
-					if len(subnetNames) > 0 {
-						props["SubnetNames"] = subnetNames
-					}
+			// サブネット名も追加
+			var subnetNames []string
+			for _, subnetID := range awsvpcConfig.Subnets {
+				subnetName, err := v.getSubnetName(ctx, subnetID)
+				if err == nil && subnetName != "" {
+					subnetNames = append(subnetNames, subnetName)
 				}
 			}
-
-			// ロードバランサー情報を追加
-			if len(service.LoadBalancers) > 0 {
-				props["LoadBalancers"] = service.LoadBalancers
+			if len(subnetNames) > 0 {
+				props["SubnetNames"] = subnetNames
 			}
+		}
+	}
 
-			// ヘルスチェックグレースピリオド
-			if service.HealthCheckGracePeriodSeconds != nil {
-				props["HealthCheckGracePeriodSeconds"] = *service.HealthCheckGracePeriodSeconds
+	// ロードバランサー情報を追加
+	if len(service.LoadBalancers) > 0 {
+		var loadBalancers []map[string]interface{}
+		for _, lb := range service.LoadBalancers {
+			loadBalancer := map[string]interface{}{}
+			if lb.TargetGroupArn != nil {
+				loadBalancer["TargetGroupArn"] = *lb.TargetGroupArn
+				loadBalancer["TargetGroupName"] = targetGroupNameFromArn(*lb.TargetGroupArn)
 			}
-
-			return true, props, nil
+			if lb.LoadBalancerName != nil {
+				loadBalancer["LoadBalancerName"] = *lb.LoadBalancerName
+			}
+			if lb.ContainerName != nil {
+				loadBalancer["ContainerName"] = *lb.ContainerName
+			}
+			if lb.ContainerPort != nil {
+				loadBalancer["ContainerPort"] = *lb.ContainerPort
+			}
+			loadBalancers = append(loadBalancers, loadBalancer)
 		}
+		props["LoadBalancers"] = loadBalancers
 	}
 
-	return false, nil, nil
+	// ヘルスチェックグレースピリオド
+	if service.HealthCheckGracePeriodSeconds != nil {
+		props["HealthCheckGracePeriodSeconds"] = *service.HealthCheckGracePeriodSeconds
+	}
+
+	// デプロイ・タスク・ターゲットの稼働状況を追加
+	v.addServiceRuntimeHealth(ctx, clusterArn, service, props)
+
+	return true, props, nil
 }
 
-func (v *ResourceValidator) checkLoadBalancer(ctx context.Context, albName string) (bool, map[string]interface{}, error) {
-	input := &elasticloadbalancingv2.DescribeLoadBalancersInput{
-		Names: []string{albName},
+// targetGroupNameFromArn はターゲットグループのARNから名前を取り出す
+// 例: arn:aws:elasticloadbalancing:ap-northeast-1:123456789012:targetgroup/sbcntr-frontapp-blue/0123456789abcdef -> sbcntr-frontapp-blue
+func targetGroupNameFromArn(arn string) string {
+	parts := strings.Split(arn, "/")
+	if len(parts) < 3 {
+		return ""
 	}
+	return parts[len(parts)-2]
+}
 
-	result, err := v.awsClient.ELBv2.DescribeLoadBalancers(ctx, input)
-	if err != nil {
-		return false, nil, nil
+// maxServiceEvents はプロパティに含めるサービスイベントの最大件数
+const maxServiceEvents = 10
+
+// maxStoppedTasks はプロパティに含める停止済みタスクの最大件数
+const maxStoppedTasks = 10
+
+// addServiceRuntimeHealth はECSサービスのデプロイ状況、イベント、タスク、ターゲットヘルスをpropsに追加する
+func (v *ResourceValidator) addServiceRuntimeHealth(ctx context.Context, clusterArn string, service ecstypes.Service, props map[string]interface{}) {
+	// デプロイ状況（PRIMARYを先頭にする）
+	deployments := []map[string]interface{}{}
+	for _, deployment := range service.Deployments {
+		d := map[string]interface{}{
+			"DesiredCount": deployment.DesiredCount,
+			"RunningCount": deployment.RunningCount,
+			"PendingCount": deployment.PendingCount,
+			"FailedTasks":  deployment.FailedTasks,
+			"RolloutState": string(deployment.RolloutState),
+		}
+		if deployment.Id != nil {
+			d["Id"] = *deployment.Id
+		}
+		if deployment.Status != nil {
+			d["Status"] = *deployment.Status
+		}
+		if deployment.TaskDefinition != nil {
+			d["TaskDefinition"] = *deployment.TaskDefinition
+		}
+		if deployment.RolloutStateReason != nil {
+			d["RolloutStateReason"] = *deployment.RolloutStateReason
+		}
+		if deployment.Status != nil && *deployment.Status == "PRIMARY" {
+			deployments = append([]map[string]interface{}{d}, deployments...)
+		} else {
+			deployments = append(deployments, d)
+		}
 	}
+	props["Deployments"] = deployments
 
-	if len(result.LoadBalancers) == 0 {
-		return false, nil, nil
+	// 直近のサービスイベント（新しい順）
+	events := []map[string]interface{}{}
+	for i, event := range service.Events {
+		if i >= maxServiceEvents {
+			break
+		}
+		e := map[string]interface{}{}
+		if event.CreatedAt != nil {
+			e["CreatedAt"] = event.CreatedAt.Format(time.RFC3339)
+		}
+		if event.Message != nil {
+			e["Message"] = *event.Message
+		}
+		events = append(events, e)
 	}
+	props["Events"] = events
 
-	alb := result.LoadBalancers[0]
-	props := map[string]interface{}{
-		"LoadBalancerName": *alb.LoadBalancerName,
-		"Type":             string(alb.Type),
+	// 実行中および停止済みのタスク（取得できない場合はその理由）
+	if service.ServiceName != nil {
+		runningTasks, err := v.getServiceTasks(ctx, clusterArn, *service.ServiceName, ecstypes.DesiredStatusRunning, 0)
+		if err != nil {
+			props["RunningTasksError"] = err.Error()
+		} else {
+			props["RunningTasks"] = runningTasks
+		}
+
+		stoppedTasks, err := v.getServiceTasks(ctx, clusterArn, *service.ServiceName, ecstypes.DesiredStatusStopped, maxStoppedTasks)
+		if err != nil {
+			props["StoppedTasksError"] = err.Error()
+		} else {
+			props["StoppedTasks"] = stoppedTasks
+		}
 	}
 
-	// State.Codeをネストされたプロパティとして追加
-	if alb.State != nil {
-		props["State"] = map[string]interface{}{
-			"Code": string(alb.State.Code),
+	// ターゲットグループに登録されたターゲットのヘルス
+	var targetGroupArns []string
+	for _, lb := range service.LoadBalancers {
+		if lb.TargetGroupArn != nil {
+			targetGroupArns = append(targetGroupArns, *lb.TargetGroupArn)
+		}
+	}
+	if len(targetGroupArns) > 0 {
+		targetHealth, allHealthy := v.getTargetHealth(ctx, targetGroupArns)
+		props["TargetHealth"] = targetHealth
+		props["AllTargetsHealthy"] = allHealthy
+	}
+}
+
+// getServiceTasks は指定したステータスのサービスのタスクを取得する（limitが0の場合は全件）
+func (v *ResourceValidator) getServiceTasks(ctx context.Context, clusterArn, serviceName string, desiredStatus ecstypes.DesiredStatus, limit int) ([]map[string]interface{}, error) {
+	var taskArns []string
+	paginator := ecs.NewListTasksPaginator(v.awsClient.ECS, &ecs.ListTasksInput{
+		Cluster:       &clusterArn,
+		ServiceName:   &serviceName,
+		DesiredStatus: desiredStatus,
+	})
+	for paginator.HasMorePages() && (limit == 0 || len(taskArns) < limit) {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		taskArns = append(taskArns, page.TaskArns...)
+	}
+	if limit > 0 && len(taskArns) > limit {
+		taskArns = taskArns[:limit]
+	}
+
+	tasks := []map[string]interface{}{}
+
+	// DescribeTasksは1回あたり100件まで
+	for start := 0; start < len(taskArns); start += 100 {
+		end := start + 100
+		if end > len(taskArns) {
+			end = len(taskArns)
+		}
+
+		result, err := v.awsClient.ECS.DescribeTasks(ctx, &ecs.DescribeTasksInput{
+			Cluster: &clusterArn,
+			Tasks:   taskArns[start:end],
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, task := range result.Tasks {
+			t := map[string]interface{}{
+				"HealthStatus": string(task.HealthStatus),
+			}
+			if task.TaskArn != nil {
+				t["TaskArn"] = *task.TaskArn
+			}
+			if task.TaskDefinitionArn != nil {
+				t["TaskDefinitionArn"] = *task.TaskDefinitionArn
+			}
+			if task.LastStatus != nil {
+				t["LastStatus"] = *task.LastStatus
+			}
+			if task.StartedAt != nil {
+				t["StartedAt"] = task.StartedAt.Format(time.RFC3339)
+			}
+			if task.StoppedAt != nil {
+				t["StoppedAt"] = task.StoppedAt.Format(time.RFC3339)
+			}
+			if task.StopCode != "" {
+				t["StopCode"] = string(task.StopCode)
+			}
+			if task.StoppedReason != nil {
+				t["StoppedReason"] = *task.StoppedReason
+			}
+
+			var containers []map[string]interface{}
+			for _, container := range task.Containers {
+				c := map[string]interface{}{
+					"HealthStatus": string(container.HealthStatus),
+				}
+				if container.Name != nil {
+					c["Name"] = *container.Name
+				}
+				if container.LastStatus != nil {
+					c["LastStatus"] = *container.LastStatus
+				}
+				if container.ExitCode != nil {
+					c["ExitCode"] = *container.ExitCode
+				}
+				if container.Reason != nil {
+					c["Reason"] = *container.Reason
+				}
+				containers = append(containers, c)
+			}
+			t["Containers"] = containers
+
+			tasks = append(tasks, t)
+		}
+	}
+
+	return tasks, nil
+}
+
+// getTargetHealth はターゲットグループごとの登録ターゲットのヘルスを取得する
+// 2つ目の戻り値はすべてのターゲットグループでターゲットが1つ以上かつ全てhealthyかどうか
+func (v *ResourceValidator) getTargetHealth(ctx context.Context, targetGroupArns []string) ([]map[string]interface{}, bool) {
+	allHealthy := true
+	targetGroups := []map[string]interface{}{}
+
+	for _, tgArn := range targetGroupArns {
+		tg := map[string]interface{}{
+			"TargetGroupArn": tgArn,
+		}
+
+		result, err := v.awsClient.ELBv2.DescribeTargetHealth(ctx, &elasticloadbalancingv2.DescribeTargetHealthInput{
+			TargetGroupArn: awsutil.String(tgArn),
+		})
+		if err != nil {
+			allHealthy = false
+			tg["Error"] = err.Error()
+			targetGroups = append(targetGroups, tg)
+			continue
+		}
+
+		healthyCount := 0
+		targets := []map[string]interface{}{}
+		for _, desc := range result.TargetHealthDescriptions {
+			t := map[string]interface{}{}
+			if desc.Target != nil {
+				if desc.Target.Id != nil {
+					t["Id"] = *desc.Target.Id
+				}
+				if desc.Target.Port != nil {
+					t["Port"] = *desc.Target.Port
+				}
+			}
+			if desc.TargetHealth != nil {
+				t["State"] = string(desc.TargetHealth.State)
+				if desc.TargetHealth.Reason != "" {
+					t["Reason"] = string(desc.TargetHealth.Reason)
+				}
+				if desc.TargetHealth.Description != nil {
+					t["Description"] = *desc.TargetHealth.Description
+				}
+				if desc.TargetHealth.State == elbv2types.TargetHealthStateEnumHealthy {
+					healthyCount++
+				}
+			}
+			targets = append(targets, t)
+		}
+
+		tg["Targets"] = targets
+		tg["HealthyCount"] = healthyCount
+		tg["UnhealthyCount"] = len(targets) - healthyCount
+		if len(targets) == 0 || healthyCount != len(targets) {
+			allHealthy = false
+		}
+
+		targetGroups = append(targetGroups, tg)
+	}
+
+	return targetGroups, allHealthy
+}
+
+func (v *ResourceValidator) checkLoadBalancer(ctx context.Context, albName string) (bool, map[string]interface{}, error) {
+	input := &elasticloadbalancingv2.DescribeLoadBalancersInput{
+		Names: []string{albName},
+	}
+
+	result, err := v.awsClient.ELBv2.DescribeLoadBalancers(ctx, input)
+	if err != nil {
+		return false, nil, nil
+	}
+
+	if len(result.LoadBalancers) == 0 {
+		return false, nil, nil
+	}
+
+	alb := result.LoadBalancers[0]
+	props := map[string]interface{}{
+		"LoadBalancerName": *alb.LoadBalancerName,
+		"Type":             string(alb.Type),
+	}
+
+	// State.Codeをネストされたプロパティとして追加
+	if alb.State != nil {
+		props["State"] = map[string]interface{}{
+			"Code": string(alb.State.Code),
 		}
 	}
 
@@ -1048,9 +1879,238 @@ func (v *ResourceValidator) checkLoadBalancer(ctx context.Context, albName strin
 		props["AvailabilityZones"] = alb.AvailabilityZones
 	}
 
+	// リスナーとリスナールールを追加
+	if alb.LoadBalancerArn != nil {
+		props["LoadBalancerArn"] = *alb.LoadBalancerArn
+
+		listeners, err := v.getListeners(ctx, *alb.LoadBalancerArn)
+		if err != nil {
+			props["ListenersError"] = err.Error()
+		} else {
+			props["Listeners"] = listeners
+		}
+	}
+
 	return true, props, nil
 }
 
+// checkListener はリスナーを "<ロードバランサー名>:<ポート>" の形式で指定して取得する
+// 例: "sbcntr-ingress:80"
+func (v *ResourceValidator) checkListener(ctx context.Context, listenerName string) (bool, map[string]interface{}, error) {
+	idx := strings.LastIndex(listenerName, ":")
+	if idx < 0 {
+		return false, nil, fmt.Errorf("listener name must be in the form <load balancer name>:<port>: %s", listenerName)
+	}
+
+	albName := listenerName[:idx]
+	port, err := strconv.Atoi(listenerName[idx+1:])
+	if err != nil {
+		return false, nil, fmt.Errorf("invalid listener port in %s: %w", listenerName, err)
+	}
+
+	result, err := v.awsClient.ELBv2.DescribeLoadBalancers(ctx, &elasticloadbalancingv2.DescribeLoadBalancersInput{
+		Names: []string{albName},
+	})
+	if err != nil {
+		return false, nil, nil
+	}
+
+	if len(result.LoadBalancers) == 0 || result.LoadBalancers[0].LoadBalancerArn == nil {
+		return false, nil, nil
+	}
+
+	listeners, err := v.getListeners(ctx, *result.LoadBalancers[0].LoadBalancerArn)
+	if err != nil {
+		return false, nil, err
+	}
+
+	for _, listener := range listeners {
+		if listenerPort, ok := listener["Port"].(int32); ok && int(listenerPort) == port {
+			listener["LoadBalancerName"] = albName
+			return true, listener, nil
+		}
+	}
+
+	return false, nil, nil
+}
+
+// getListeners はロードバランサーのリスナーとそのルールを正規化して取得する
+// ルールを取得できなかったリスナーにはRulesではなくRulesErrorを設定する
+func (v *ResourceValidator) getListeners(ctx context.Context, loadBalancerArn string) ([]map[string]interface{}, error) {
+	listeners := []map[string]interface{}{}
+
+	paginator := elasticloadbalancingv2.NewDescribeListenersPaginator(v.awsClient.ELBv2, &elasticloadbalancingv2.DescribeListenersInput{
+		LoadBalancerArn: &loadBalancerArn,
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe listeners for %s: %w", loadBalancerArn, err)
+		}
+
+		for _, l := range page.Listeners {
+			listener := map[string]interface{}{
+				"Protocol": string(l.Protocol),
+			}
+			if l.ListenerArn != nil {
+				listener["ListenerArn"] = *l.ListenerArn
+			}
+			if l.Port != nil {
+				listener["Port"] = *l.Port
+			}
+
+			defaultActions := normalizeListenerActions(l.DefaultActions)
+			listener["DefaultActions"] = defaultActions
+			listener["ForwardTargetGroupNames"] = forwardTargetGroupNames(defaultActions)
+
+			if l.ListenerArn != nil {
+				rules, err := v.getListenerRules(ctx, *l.ListenerArn)
+				if err != nil {
+					listener["RulesError"] = err.Error()
+				} else {
+					listener["Rules"] = rules
+				}
+			}
+
+			listeners = append(listeners, listener)
+		}
+	}
+
+	return listeners, nil
+}
+
+// getListenerRules はリスナーのルールを正規化して取得する
+func (v *ResourceValidator) getListenerRules(ctx context.Context, listenerArn string) ([]map[string]interface{}, error) {
+	rules := []map[string]interface{}{}
+
+	input := &elasticloadbalancingv2.DescribeRulesInput{
+		ListenerArn: &listenerArn,
+	}
+	for {
+		result, err := v.awsClient.ELBv2.DescribeRules(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe rules for %s: %w", listenerArn, err)
+		}
+
+		for _, r := range result.Rules {
+			rule := map[string]interface{}{
+				"Priority":  awsutil.ToString(r.Priority),
+				"IsDefault": awsutil.ToBool(r.IsDefault),
+			}
+			if r.RuleArn != nil {
+				rule["RuleArn"] = *r.RuleArn
+			}
+
+			conditions := []map[string]interface{}{}
+			for _, c := range r.Conditions {
+				condition := map[string]interface{}{
+					"Field": awsutil.ToString(c.Field),
+				}
+				values := c.Values
+				if c.PathPatternConfig != nil {
+					values = append(values, c.PathPatternConfig.Values...)
+				}
+				if c.HostHeaderConfig != nil {
+					values = append(values, c.HostHeaderConfig.Values...)
+				}
+				condition["Values"] = values
+				conditions = append(conditions, condition)
+			}
+			rule["Conditions"] = conditions
+
+			actions := normalizeListenerActions(r.Actions)
+			rule["Actions"] = actions
+			rule["ForwardTargetGroupNames"] = forwardTargetGroupNames(actions)
+
+			rules = append(rules, rule)
+		}
+
+		if result.NextMarker == nil {
+			break
+		}
+		input.Marker = result.NextMarker
+	}
+
+	return rules, nil
+}
+
+// normalizeListenerActions はリスナーのアクションをmapに変換する
+// 転送先はターゲットグループ名と重みで表現する
+func normalizeListenerActions(actions []elbv2types.Action) []map[string]interface{} {
+	normalized := []map[string]interface{}{}
+
+	for _, a := range actions {
+		action := map[string]interface{}{
+			"Type": string(a.Type),
+		}
+		if a.Order != nil {
+			action["Order"] = *a.Order
+		}
+
+		targetGroups := []map[string]interface{}{}
+		if a.ForwardConfig != nil && len(a.ForwardConfig.TargetGroups) > 0 {
+			for _, tg := range a.ForwardConfig.TargetGroups {
+				targetGroup := map[string]interface{}{
+					"TargetGroupArn":  awsutil.ToString(tg.TargetGroupArn),
+					"TargetGroupName": targetGroupNameFromArn(awsutil.ToString(tg.TargetGroupArn)),
+				}
+				if tg.Weight != nil {
+					targetGroup["Weight"] = *tg.Weight
+				}
+				targetGroups = append(targetGroups, targetGroup)
+			}
+		} else if a.TargetGroupArn != nil {
+			// 単一ターゲットグループへの転送は重み1として扱う
+			targetGroups = append(targetGroups, map[string]interface{}{
+				"TargetGroupArn":  *a.TargetGroupArn,
+				"TargetGroupName": targetGroupNameFromArn(*a.TargetGroupArn),
+				"Weight":          int32(1),
+			})
+		}
+		if a.Type == elbv2types.ActionTypeEnumForward {
+			action["TargetGroups"] = targetGroups
+		}
+
+		if a.RedirectConfig != nil {
+			action["RedirectConfig"] = map[string]interface{}{
+				"Protocol":   awsutil.ToString(a.RedirectConfig.Protocol),
+				"Port":       awsutil.ToString(a.RedirectConfig.Port),
+				"StatusCode": string(a.RedirectConfig.StatusCode),
+			}
+		}
+		if a.FixedResponseConfig != nil {
+			action["FixedResponseConfig"] = map[string]interface{}{
+				"StatusCode":  awsutil.ToString(a.FixedResponseConfig.StatusCode),
+				"ContentType": awsutil.ToString(a.FixedResponseConfig.ContentType),
+			}
+		}
+
+		normalized = append(normalized, action)
+	}
+
+	return normalized
+}
+
+// forwardTargetGroupNames はアクションの転送先ターゲットグループ名を重み0を除いて列挙する
+func forwardTargetGroupNames(actions []map[string]interface{}) []string {
+	names := []string{}
+	for _, action := range actions {
+		targetGroups, ok := action["TargetGroups"].([]map[string]interface{})
+		if !ok {
+			continue
+		}
+		for _, tg := range targetGroups {
+			if weight, ok := tg["Weight"].(int32); ok && weight == 0 {
+				continue
+			}
+			if name, ok := tg["TargetGroupName"].(string); ok && name != "" {
+				names = append(names, name)
+			}
+		}
+	}
+	return names
+}
+
 func (v *ResourceValidator) checkTargetGroup(ctx context.Context, tgName string) (bool, map[string]interface{}, error) {
 	input := &elasticloadbalancingv2.DescribeTargetGroupsInput{
 		Names: []string{tgName},
@@ -1102,66 +2162,300 @@ func (v *ResourceValidator) checkTargetGroup(ctx context.Context, tgName string)
 		props["HealthCheckPort"] = *tg.HealthCheckPort
 	}
 
+	// ヘルスチェックの間隔・タイムアウト・しきい値を追加
+	if tg.HealthCheckIntervalSeconds != nil {
+		props["HealthCheckIntervalSeconds"] = *tg.HealthCheckIntervalSeconds
+	}
+	if tg.HealthCheckTimeoutSeconds != nil {
+		props["HealthCheckTimeoutSeconds"] = *tg.HealthCheckTimeoutSeconds
+	}
+	if tg.HealthyThresholdCount != nil {
+		props["HealthyThresholdCount"] = *tg.HealthyThresholdCount
+	}
+	if tg.UnhealthyThresholdCount != nil {
+		props["UnhealthyThresholdCount"] = *tg.UnhealthyThresholdCount
+	}
+
+	// 成功コードを追加
+	if tg.Matcher != nil {
+		matcher := map[string]interface{}{}
+		if tg.Matcher.HttpCode != nil {
+			matcher["HttpCode"] = *tg.Matcher.HttpCode
+		}
+		if tg.Matcher.GrpcCode != nil {
+			matcher["GrpcCode"] = *tg.Matcher.GrpcCode
+		}
+		props["Matcher"] = matcher
+	}
+
+	// 関連付けられているロードバランサーを追加
+	// リスナーやリスナールールから参照されていないターゲットグループは空になる
+	loadBalancerNames := []string{}
+	for _, lbArn := range tg.LoadBalancerArns {
+		loadBalancerNames = append(loadBalancerNames, loadBalancerNameFromArn(lbArn))
+	}
+	props["LoadBalancerArns"] = tg.LoadBalancerArns
+	props["LoadBalancerNames"] = loadBalancerNames
+	props["AttachedToLoadBalancer"] = len(tg.LoadBalancerArns) > 0
+
+	// ターゲットグループ属性を追加
+	if tg.TargetGroupArn != nil {
+		props["TargetGroupArn"] = *tg.TargetGroupArn
+
+		attrResult, err := v.awsClient.ELBv2.DescribeTargetGroupAttributes(ctx, &elasticloadbalancingv2.DescribeTargetGroupAttributesInput{
+			TargetGroupArn: tg.TargetGroupArn,
+		})
+		if err == nil && attrResult != nil {
+			attributes := map[string]interface{}{}
+			for _, attr := range attrResult.Attributes {
+				if attr.Key != nil && attr.Value != nil {
+					attributes[*attr.Key] = *attr.Value
+				}
+			}
+			props["Attributes"] = attributes
+
+			// 属性キーには"."が含まれルールから辿れないため、よく使う属性は個別のプロパティにする
+			if delay, ok := attributes["deregistration_delay.timeout_seconds"].(string); ok {
+				if seconds, err := strconv.Atoi(delay); err == nil {
+					props["DeregistrationDelaySeconds"] = seconds
+				}
+			}
+
+			stickiness := map[string]interface{}{
+				"Enabled": attributes["stickiness.enabled"] == "true",
+			}
+			if stickinessType, ok := attributes["stickiness.type"].(string); ok {
+				stickiness["Type"] = stickinessType
+			}
+			if duration, ok := attributes["stickiness.lb_cookie.duration_seconds"].(string); ok {
+				if seconds, err := strconv.Atoi(duration); err == nil {
+					stickiness["DurationSeconds"] = seconds
+				}
+			}
+			props["Stickiness"] = stickiness
+		}
+	}
+
 	return true, props, nil
 }
 
+// loadBalancerNameFromArn はロードバランサーのARNから名前を取り出す
+// 例: arn:aws:elasticloadbalancing:ap-northeast-1:123456789012:loadbalancer/app/sbcntr-ingress/0123456789abcdef -> sbcntr-ingress
+func loadBalancerNameFromArn(arn string) string {
+	parts := strings.Split(arn, "/")
+	if len(parts) < 4 {
+		return ""
+	}
+	return parts[len(parts)-2]
+}
+
 func (v *ResourceValidator) checkDBCluster(ctx context.Context, clusterIdentifier string) (bool, map[string]interface{}, error) {
-	resource, err := v.awsClient.GetResource(ctx, "AWS::RDS::DBCluster", clusterIdentifier)
+	result, err := v.awsClient.RDS.DescribeDBClusters(ctx, &rds.DescribeDBClustersInput{
+		DBClusterIdentifier: &clusterIdentifier,
+	})
 	if err != nil {
 		return false, nil, nil
 	}
 
-	return true, resource.Properties, nil
+	if len(result.DBClusters) == 0 {
+		return false, nil, nil
+	}
+
+	cluster := result.DBClusters[0]
+	props := map[string]interface{}{
+		"DBClusterIdentifier": awsutil.ToString(cluster.DBClusterIdentifier),
+		"Status":              awsutil.ToString(cluster.Status),
+		"Engine":              awsutil.ToString(cluster.Engine),
+		"EngineVersion":       awsutil.ToString(cluster.EngineVersion),
+		"MultiAZ":             awsutil.ToBool(cluster.MultiAZ),
+		"StorageEncrypted":    awsutil.ToBool(cluster.StorageEncrypted),
+		"DeletionProtection":  awsutil.ToBool(cluster.DeletionProtection),
+	}
+
+	if cluster.DBClusterArn != nil {
+		props["DBClusterArn"] = *cluster.DBClusterArn
+	}
+	if cluster.MasterUsername != nil {
+		props["MasterUsername"] = *cluster.MasterUsername
+	}
+	if cluster.DatabaseName != nil {
+		props["DatabaseName"] = *cluster.DatabaseName
+	}
+	if cluster.KmsKeyId != nil {
+		props["KmsKeyId"] = *cluster.KmsKeyId
+	}
+
+	// エンドポイント
+	endpoint := map[string]interface{}{
+		"Address": awsutil.ToString(cluster.Endpoint),
+	}
+	if cluster.Port != nil {
+		props["Port"] = *cluster.Port
+		endpoint["Port"] = *cluster.Port
+	}
+	props["Endpoint"] = endpoint
+	if cluster.ReaderEndpoint != nil {
+		props["ReaderEndpoint"] = map[string]interface{}{
+			"Address": *cluster.ReaderEndpoint,
+		}
+	}
+
+	// クラスターメンバー
+	members := []map[string]interface{}{}
+	for _, member := range cluster.DBClusterMembers {
+		members = append(members, map[string]interface{}{
+			"DBInstanceIdentifier": awsutil.ToString(member.DBInstanceIdentifier),
+			"IsClusterWriter":      awsutil.ToBool(member.IsClusterWriter),
+		})
+	}
+	props["DBClusterMembers"] = members
+
+	// サブネットグループ
+	if cluster.DBSubnetGroup != nil {
+		props["DBSubnetGroupName"] = *cluster.DBSubnetGroup
+	}
+
+	// セキュリティグループ（IDとNameタグ）
+	sgIDs, sgNames := v.rdsSecurityGroups(ctx, cluster.VpcSecurityGroups)
+	props["VpcSecurityGroupIds"] = sgIDs
+	props["VPCSecurityGroups"] = sgNames
+
+	return true, props, nil
 }
 
 func (v *ResourceValidator) checkDBInstance(ctx context.Context, instanceIdentifier string) (bool, map[string]interface{}, error) {
-	resource, err := v.awsClient.GetResource(ctx, "AWS::RDS::DBInstance", instanceIdentifier)
+	result, err := v.awsClient.RDS.DescribeDBInstances(ctx, &rds.DescribeDBInstancesInput{
+		DBInstanceIdentifier: &instanceIdentifier,
+	})
 	if err != nil {
 		return false, nil, nil
 	}
 
-	// VPCSecurityGroupsのIDをNameタグに変換
-	if vpcSgIds, ok := resource.Properties["VPCSecurityGroups"]; ok {
-		if sgArray, ok := vpcSgIds.([]interface{}); ok {
-			var sgNames []interface{}
-			for _, sgId := range sgArray {
-				if sgIdStr, ok := sgId.(string); ok {
-					// セキュリティグループIDからNameタグを取得
-					sgName, err := v.getSecurityGroupName(ctx, sgIdStr)
-					if err == nil && sgName != "" {
-						sgNames = append(sgNames, sgName)
-					} else {
-						// Nameタグが取得できない場合はIDをそのまま使用
-						sgNames = append(sgNames, sgIdStr)
-					}
-				}
-			}
-			// VPCSecurityGroupsをNameタグの配列に置き換え
-			if len(sgNames) > 0 {
-				resource.Properties["VPCSecurityGroups"] = sgNames
-			}
+	if len(result.DBInstances) == 0 {
+		return false, nil, nil
+	}
+
+	instance := result.DBInstances[0]
+	props := map[string]interface{}{
+		"DBInstanceIdentifier": awsutil.ToString(instance.DBInstanceIdentifier),
+		"DBInstanceStatus":     awsutil.ToString(instance.DBInstanceStatus),
+		"DBInstanceClass":      awsutil.ToString(instance.DBInstanceClass),
+		"Engine":               awsutil.ToString(instance.Engine),
+		"EngineVersion":        awsutil.ToString(instance.EngineVersion),
+		"MultiAZ":              awsutil.ToBool(instance.MultiAZ),
+		"StorageEncrypted":     awsutil.ToBool(instance.StorageEncrypted),
+		"PubliclyAccessible":   awsutil.ToBool(instance.PubliclyAccessible),
+	}
+
+	if instance.DBInstanceArn != nil {
+		props["DBInstanceArn"] = *instance.DBInstanceArn
+	}
+	if instance.DBClusterIdentifier != nil {
+		props["DBClusterIdentifier"] = *instance.DBClusterIdentifier
+	}
+	if instance.AvailabilityZone != nil {
+		props["AvailabilityZone"] = *instance.AvailabilityZone
+	}
+	if instance.KmsKeyId != nil {
+		props["KmsKeyId"] = *instance.KmsKeyId
+	}
+
+	// エンドポイント（作成中は未設定）
+	if instance.Endpoint != nil {
+		endpoint := map[string]interface{}{
+			"Address": awsutil.ToString(instance.Endpoint.Address),
+		}
+		if instance.Endpoint.Port != nil {
+			endpoint["Port"] = *instance.Endpoint.Port
 		}
+		props["Endpoint"] = endpoint
 	}
 
-	return true, resource.Properties, nil
+	// サブネットグループとサブネット名
+	if instance.DBSubnetGroup != nil {
+		for key, value := range v.normalizeDBSubnetGroup(ctx, *instance.DBSubnetGroup) {
+			props[key] = value
+		}
+	}
+
+	// セキュリティグループ（VPCSecurityGroupsはNameタグ、取得できない場合はID）
+	sgIDs, sgNames := v.rdsSecurityGroups(ctx, instance.VpcSecurityGroups)
+	props["VpcSecurityGroupIds"] = sgIDs
+	props["VPCSecurityGroups"] = sgNames
+
+	return true, props, nil
 }
 
 func (v *ResourceValidator) checkDBSubnetGroup(ctx context.Context, subnetGroupName string) (bool, map[string]interface{}, error) {
-	resource, err := v.awsClient.GetResource(ctx, "AWS::RDS::DBSubnetGroup", subnetGroupName)
+	result, err := v.awsClient.RDS.DescribeDBSubnetGroups(ctx, &rds.DescribeDBSubnetGroupsInput{
+		DBSubnetGroupName: &subnetGroupName,
+	})
 	if err != nil {
 		return false, nil, nil
 	}
 
-	return true, resource.Properties, nil
+	if len(result.DBSubnetGroups) == 0 {
+		return false, nil, nil
+	}
+
+	return true, v.normalizeDBSubnetGroup(ctx, result.DBSubnetGroups[0]), nil
 }
 
-func (v *ResourceValidator) checkCloudControlResource(ctx context.Context, resourceType, resourceName string) (bool, map[string]interface{}, error) {
-	resource, err := v.awsClient.GetResource(ctx, resourceType, resourceName)
-	if err != nil {
-		return false, nil, nil
+// normalizeDBSubnetGroup はDBサブネットグループをサブネット名付きのmapに変換する
+func (v *ResourceValidator) normalizeDBSubnetGroup(ctx context.Context, subnetGroup rdstypes.DBSubnetGroup) map[string]interface{} {
+	props := map[string]interface{}{
+		"DBSubnetGroupName":        awsutil.ToString(subnetGroup.DBSubnetGroupName),
+		"DBSubnetGroupDescription": awsutil.ToString(subnetGroup.DBSubnetGroupDescription),
+		"SubnetGroupStatus":        awsutil.ToString(subnetGroup.SubnetGroupStatus),
+		"VpcId":                    awsutil.ToString(subnetGroup.VpcId),
+	}
+
+	subnetIDs := []string{}
+	subnetNames := []string{}
+	availabilityZones := []string{}
+	for _, subnet := range subnetGroup.Subnets {
+		if subnet.SubnetIdentifier == nil {
+			continue
+		}
+		subnetIDs = append(subnetIDs, *subnet.SubnetIdentifier)
+
+		// サブネットIDからNameタグを取得
+		subnetName, err := v.getSubnetName(ctx, *subnet.SubnetIdentifier)
+		if err == nil && subnetName != "" {
+			subnetNames = append(subnetNames, subnetName)
+		}
+
+		if subnet.SubnetAvailabilityZone != nil && subnet.SubnetAvailabilityZone.Name != nil {
+			availabilityZones = append(availabilityZones, *subnet.SubnetAvailabilityZone.Name)
+		}
 	}
+	props["SubnetIds"] = subnetIDs
+	props["SubnetNames"] = subnetNames
+	props["AvailabilityZones"] = availabilityZones
 
-	return true, resource.Properties, nil
+	return props
+}
+
+// rdsSecurityGroups はRDSのセキュリティグループのIDとNameタグを返す
+// Nameタグが取得できない場合はIDをそのまま名前として使用する
+func (v *ResourceValidator) rdsSecurityGroups(ctx context.Context, memberships []rdstypes.VpcSecurityGroupMembership) ([]string, []string) {
+	ids := []string{}
+	names := []string{}
+	for _, membership := range memberships {
+		if membership.VpcSecurityGroupId == nil {
+			continue
+		}
+		sgID := *membership.VpcSecurityGroupId
+		ids = append(ids, sgID)
+
+		sgName, err := v.getSecurityGroupName(ctx, sgID)
+		if err == nil && sgName != "" {
+			names = append(names, sgName)
+		} else {
+			names = append(names, sgID)
+		}
+	}
+	return ids, names
 }
 
 func (v *ResourceValidator) checkIAMRole(ctx context.Context, roleName string) (bool, map[string]interface{}, error) {
@@ -1187,39 +2481,404 @@ func (v *ResourceValidator) checkIAMRole(ctx context.Context, roleName string) (
 
 	// AssumeRolePolicyDocumentを追加
 	if role.AssumeRolePolicyDocument != nil {
-		var assumeRolePolicy map[string]interface{}
-		// URLデコードが必要な場合があるので、デコード処理を追加
-		policyDoc := *role.AssumeRolePolicyDocument
-
-		// URLデコードが必要な場合
-		decodedPolicy, err := url.QueryUnescape(policyDoc)
-		if err == nil {
-			policyDoc = decodedPolicy
-		}
-
-		err = json.Unmarshal([]byte(policyDoc), &assumeRolePolicy)
+		assumeRolePolicy, err := decodePolicyDocument(*role.AssumeRolePolicyDocument)
 		if err == nil {
 			props["AssumeRolePolicyDocument"] = assumeRolePolicy
 		}
 	}
 
-	// アタッチされているマネージドポリシーを取得
-	listPoliciesInput := &iam.ListAttachedRolePoliciesInput{
+	// allowsルールで評価するアイデンティティベースのポリシー
+	policyDocuments := []map[string]interface{}{}
+
+	// アタッチされているマネージドポリシーとデフォルトバージョンのドキュメントを取得
+	attachedPolicies := []map[string]interface{}{}
+	attachedPaginator := iam.NewListAttachedRolePoliciesPaginator(v.awsClient.IAM, &iam.ListAttachedRolePoliciesInput{
 		RoleName: &roleName,
-	}
+	})
+	for attachedPaginator.HasMorePages() {
+		page, err := attachedPaginator.NextPage(ctx)
+		if err != nil {
+			break
+		}
 
-	policiesResult, err := v.awsClient.IAM.ListAttachedRolePolicies(ctx, listPoliciesInput)
-	if err == nil && policiesResult != nil {
-		var attachedPolicies []map[string]interface{}
-		for _, policy := range policiesResult.AttachedPolicies {
+		for _, policy := range page.AttachedPolicies {
 			attachedPolicy := map[string]interface{}{
 				"PolicyArn":  *policy.PolicyArn,
 				"PolicyName": *policy.PolicyName,
 			}
+
+			document, err := v.getManagedPolicyDocument(ctx, *policy.PolicyArn)
+			if err == nil {
+				attachedPolicy["PolicyDocument"] = document
+				policyDocuments = append(policyDocuments, document)
+			}
+
 			attachedPolicies = append(attachedPolicies, attachedPolicy)
 		}
-		props["AttachedManagedPolicies"] = attachedPolicies
 	}
+	props["AttachedManagedPolicies"] = attachedPolicies
+
+	// インラインポリシーを取得
+	inlinePolicies := []map[string]interface{}{}
+	inlinePaginator := iam.NewListRolePoliciesPaginator(v.awsClient.IAM, &iam.ListRolePoliciesInput{
+		RoleName: &roleName,
+	})
+	for inlinePaginator.HasMorePages() {
+		page, err := inlinePaginator.NextPage(ctx)
+		if err != nil {
+			break
+		}
+
+		for _, policyName := range page.PolicyNames {
+			inlinePolicy := map[string]interface{}{
+				"PolicyName": policyName,
+			}
+
+			policyResult, err := v.awsClient.IAM.GetRolePolicy(ctx, &iam.GetRolePolicyInput{
+				RoleName:   &roleName,
+				PolicyName: awsutil.String(policyName),
+			})
+			if err == nil && policyResult.PolicyDocument != nil {
+				document, err := decodePolicyDocument(*policyResult.PolicyDocument)
+				if err == nil {
+					inlinePolicy["PolicyDocument"] = document
+					policyDocuments = append(policyDocuments, document)
+				}
+			}
+
+			inlinePolicies = append(inlinePolicies, inlinePolicy)
+		}
+	}
+	props["InlinePolicies"] = inlinePolicies
+	props["PolicyDocuments"] = policyDocuments
 
 	return true, props, nil
 }
+
+// getManagedPolicyDocument はマネージドポリシーのデフォルトバージョンのドキュメントを取得する
+func (v *ResourceValidator) getManagedPolicyDocument(ctx context.Context, policyArn string) (map[string]interface{}, error) {
+	policyResult, err := v.awsClient.IAM.GetPolicy(ctx, &iam.GetPolicyInput{
+		PolicyArn: &policyArn,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if policyResult.Policy == nil || policyResult.Policy.DefaultVersionId == nil {
+		return nil, fmt.Errorf("default version of policy %s not found", policyArn)
+	}
+
+	versionResult, err := v.awsClient.IAM.GetPolicyVersion(ctx, &iam.GetPolicyVersionInput{
+		PolicyArn: &policyArn,
+		VersionId: policyResult.Policy.DefaultVersionId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if versionResult.PolicyVersion == nil || versionResult.PolicyVersion.Document == nil {
+		return nil, fmt.Errorf("document of policy %s not found", policyArn)
+	}
+
+	return decodePolicyDocument(*versionResult.PolicyVersion.Document)
+}
+
+func (v *ResourceValidator) checkSecret(ctx context.Context, secretName string) (bool, map[string]interface{}, error) {
+	result, err := v.awsClient.SecretsManager.DescribeSecret(ctx, &secretsmanager.DescribeSecretInput{
+		SecretId: &secretName,
+	})
+	if err != nil {
+		return false, nil, nil
+	}
+
+	// 削除予定のシークレットは存在しないものとして扱う
+	if result.DeletedDate != nil {
+		return false, nil, nil
+	}
+
+	// 秘匿情報の値そのものはプロパティに含めない
+	props := map[string]interface{}{
+		"Name":            awsutil.ToString(result.Name),
+		"ARN":             awsutil.ToString(result.ARN),
+		"RotationEnabled": awsutil.ToBool(result.RotationEnabled),
+	}
+
+	// KMSキー（未指定の場合はAWSマネージドキー）
+	if result.KmsKeyId != nil {
+		props["KmsKeyId"] = *result.KmsKeyId
+	} else {
+		props["KmsKeyId"] = "aws/secretsmanager"
+	}
+
+	// ローテーション設定
+	if result.RotationLambdaARN != nil {
+		props["RotationLambdaARN"] = *result.RotationLambdaARN
+	}
+	if result.RotationRules != nil {
+		rotationRules := map[string]interface{}{}
+		if result.RotationRules.AutomaticallyAfterDays != nil {
+			rotationRules["AutomaticallyAfterDays"] = *result.RotationRules.AutomaticallyAfterDays
+		}
+		if result.RotationRules.ScheduleExpression != nil {
+			rotationRules["ScheduleExpression"] = *result.RotationRules.ScheduleExpression
+		}
+		if result.RotationRules.Duration != nil {
+			rotationRules["Duration"] = *result.RotationRules.Duration
+		}
+		props["RotationRules"] = rotationRules
+	}
+	if result.LastRotatedDate != nil {
+		props["LastRotatedDate"] = result.LastRotatedDate.Format(time.RFC3339)
+	}
+	if result.LastChangedDate != nil {
+		props["LastChangedDate"] = result.LastChangedDate.Format(time.RFC3339)
+	}
+
+	// JSON形式のシークレットに含まれるキー名（値を取得できない場合はその理由）
+	keys, isJSON, err := v.getSecretKeys(ctx, awsutil.ToString(result.ARN))
+	if err != nil {
+		props["SecretKeysError"] = err.Error()
+	} else {
+		props["SecretKeys"] = keys
+		props["IsJson"] = isJSON
+	}
+
+	// リソースポリシー
+	props["HasResourcePolicy"] = false
+	policyResult, err := v.awsClient.SecretsManager.GetResourcePolicy(ctx, &secretsmanager.GetResourcePolicyInput{
+		SecretId: result.ARN,
+	})
+	if err == nil && policyResult.ResourcePolicy != nil {
+		policy, err := decodePolicyDocument(*policyResult.ResourcePolicy)
+		if err == nil {
+			props["HasResourcePolicy"] = true
+			props["ResourcePolicy"] = policy
+		}
+	}
+
+	return true, props, nil
+}
+
+// getSecretKeys はJSON形式のシークレットのキー名だけを返す
+// 値は読み取った直後に破棄し、戻り値やエラーメッセージに含めない
+func (v *ResourceValidator) getSecretKeys(ctx context.Context, secretID string) ([]string, bool, error) {
+	result, err := v.awsClient.SecretsManager.GetSecretValue(ctx, &secretsmanager.GetSecretValueInput{
+		SecretId: &secretID,
+	})
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get secret %s: %w", secretID, err)
+	}
+
+	keys := []string{}
+	if result.SecretString == nil {
+		return keys, false, nil
+	}
+
+	var secret map[string]interface{}
+	if err := json.Unmarshal([]byte(*result.SecretString), &secret); err != nil {
+		return keys, false, nil
+	}
+
+	for key := range secret {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys, true, nil
+}
+
+func (v *ResourceValidator) checkSSMParameter(ctx context.Context, parameterName string) (bool, map[string]interface{}, error) {
+	result, err := v.awsClient.SSM.DescribeParameters(ctx, &ssm.DescribeParametersInput{
+		ParameterFilters: []ssmtypes.ParameterStringFilter{
+			{
+				Key:    awsutil.String("Name"),
+				Option: awsutil.String("Equals"),
+				Values: []string{parameterName},
+			},
+		},
+	})
+	if err != nil {
+		return false, nil, fmt.Errorf("failed to describe SSM parameter %s: %w", parameterName, err)
+	}
+
+	if len(result.Parameters) == 0 {
+		return false, nil, nil
+	}
+
+	// DescribeParametersはメタデータのみを返すため、パラメータの値は取得しない
+	parameter := result.Parameters[0]
+	props := map[string]interface{}{
+		"Name":     awsutil.ToString(parameter.Name),
+		"ARN":      awsutil.ToString(parameter.ARN),
+		"Type":     string(parameter.Type),
+		"Tier":     string(parameter.Tier),
+		"DataType": awsutil.ToString(parameter.DataType),
+		"Version":  parameter.Version,
+	}
+
+	if parameter.KeyId != nil {
+		props["KeyId"] = *parameter.KeyId
+	}
+	if parameter.LastModifiedDate != nil {
+		props["LastModifiedDate"] = parameter.LastModifiedDate.Format(time.RFC3339)
+	}
+
+	return true, props, nil
+}
+
+// resolveSecretReference はタスク定義のsecretsのvalueFromが指す
+// Secrets ManagerのシークレットまたはSSMパラメータが存在するかを確認する
+// 例: arn:aws:secretsmanager:ap-northeast-1:123456789012:secret:sbcntr/mysql-AbCdEf:username::
+// 例: arn:aws:ssm:ap-northeast-1:123456789012:parameter/sbcntr/db-host
+func (v *ResourceValidator) resolveSecretReference(ctx context.Context, valueFrom string) map[string]interface{} {
+	reference := map[string]interface{}{
+		"Resolvable": false,
+	}
+
+	parts := strings.Split(valueFrom, ":")
+	switch {
+	case strings.HasPrefix(valueFrom, "arn:") && len(parts) >= 7 && parts[2] == "secretsmanager":
+		reference["Source"] = "secretsmanager"
+
+		// JSONキーやバージョン指定を除いたシークレットのARN
+		secretArn := strings.Join(parts[:7], ":")
+		exists, secretProps, _ := v.checkSecret(ctx, secretArn)
+		if !exists {
+			return reference
+		}
+		reference["SecretName"] = secretProps["Name"]
+		reference["Resolvable"] = true
+
+		// JSONキーが指定されている場合はシークレットにキーが存在するか確認
+		// 値を取得できない（GetSecretValueの権限がない等）場合はキーを確認せず、その理由を記録する
+		if len(parts) >= 8 && parts[7] != "" {
+			reference["JsonKey"] = parts[7]
+			if keysErr, ok := secretProps["SecretKeysError"]; ok {
+				reference["SecretKeysError"] = keysErr
+				break
+			}
+			keys, _ := secretProps["SecretKeys"].([]string)
+			found := false
+			for _, key := range keys {
+				if key == parts[7] {
+					found = true
+					break
+				}
+			}
+			reference["Resolvable"] = found
+		}
+	default:
+		reference["Source"] = "ssm"
+
+		parameterName := valueFrom
+		if strings.HasPrefix(valueFrom, "arn:") && len(parts) >= 6 {
+			// ARNのresource部分は "parameter/<名前>"。階層付きの名前は先頭に"/"を付ける
+			parameterName = strings.TrimPrefix(strings.Join(parts[5:], ":"), "parameter/")
+			if strings.Contains(parameterName, "/") {
+				parameterName = "/" + parameterName
+			}
+		}
+
+		exists, _, err := v.checkSSMParameter(ctx, parameterName)
+		reference["ParameterName"] = parameterName
+		reference["Resolvable"] = exists
+		if err != nil {
+			reference["Error"] = err.Error()
+		}
+	}
+
+	return reference
+}
+
+func (v *ResourceValidator) checkLogGroup(ctx context.Context, logGroupName string) (bool, map[string]interface{}, error) {
+	var logGroup *logstypes.LogGroup
+
+	// DescribeLogGroupsは前方一致のため完全一致するロググループを探す
+	paginator := cloudwatchlogs.NewDescribeLogGroupsPaginator(v.awsClient.CloudWatchLogs, &cloudwatchlogs.DescribeLogGroupsInput{
+		LogGroupNamePrefix: &logGroupName,
+	})
+	for paginator.HasMorePages() && logGroup == nil {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return false, nil, nil
+		}
+		for i := range page.LogGroups {
+			if awsutil.ToString(page.LogGroups[i].LogGroupName) == logGroupName {
+				logGroup = &page.LogGroups[i]
+				break
+			}
+		}
+	}
+
+	if logGroup == nil {
+		return false, nil, nil
+	}
+
+	// 保持期間が未設定（0）の場合は無期限
+	props := map[string]interface{}{
+		"LogGroupName":    awsutil.ToString(logGroup.LogGroupName),
+		"Arn":             awsutil.ToString(logGroup.Arn),
+		"RetentionInDays": awsutil.ToInt32(logGroup.RetentionInDays),
+		"StoredBytes":     awsutil.ToInt64(logGroup.StoredBytes),
+		"LogGroupClass":   string(logGroup.LogGroupClass),
+	}
+
+	if logGroup.KmsKeyId != nil {
+		props["KmsKeyId"] = *logGroup.KmsKeyId
+	}
+	if logGroup.CreationTime != nil {
+		props["CreationTime"] = time.UnixMilli(*logGroup.CreationTime).UTC().Format(time.RFC3339)
+	}
+
+	// 最新のログストリームから最終イベント時刻を取得し、コンテナがログを出力しているか確認できるようにする
+	props["HasEvents"] = false
+	streamsResult, err := v.awsClient.CloudWatchLogs.DescribeLogStreams(ctx, &cloudwatchlogs.DescribeLogStreamsInput{
+		LogGroupName: logGroup.LogGroupName,
+		OrderBy:      logstypes.OrderByLastEventTime,
+		Descending:   awsutil.Bool(true),
+		Limit:        awsutil.Int32(1),
+	})
+	if err == nil && len(streamsResult.LogStreams) > 0 {
+		stream := streamsResult.LogStreams[0]
+		props["LatestLogStreamName"] = awsutil.ToString(stream.LogStreamName)
+		if stream.LastEventTimestamp != nil {
+			lastEvent := time.UnixMilli(*stream.LastEventTimestamp)
+			props["HasEvents"] = true
+			props["LastEventTimestamp"] = lastEvent.UTC().Format(time.RFC3339)
+			props["LastEventMinutesAgo"] = time.Since(lastEvent).Minutes()
+		}
+	}
+
+	return true, props, nil
+}
+
+// resolveLogGroups はawslogsドライバーを使うコンテナのawslogs-groupが存在するか確認し、
+// 各コンテナのLogConfigurationにLogGroupExistsを追加する
+// 戻り値はすべてのロググループが存在するかどうか
+func (v *ResourceValidator) resolveLogGroups(ctx context.Context, containers []map[string]interface{}) bool {
+	allExist := true
+
+	for _, container := range containers {
+		logConfig, ok := container["LogConfiguration"].(map[string]interface{})
+		if !ok || logConfig["LogDriver"] != string(ecstypes.LogDriverAwslogs) {
+			continue
+		}
+
+		options, _ := logConfig["Options"].(map[string]interface{})
+		logGroupName, _ := options["awslogs-group"].(string)
+		if logGroupName == "" {
+			logConfig["LogGroupExists"] = false
+			allExist = false
+			continue
+		}
+
+		exists, _, _ := v.checkLogGroup(ctx, logGroupName)
+		logConfig["LogGroupName"] = logGroupName
+		logConfig["LogGroupExists"] = exists
+		if !exists {
+			allExist = false
+		}
+	}
+
+	return allExist
+}
@@ -1,6 +1,9 @@
 package validator
 
-import "time"
+import (
+	"sbcntr2-test-tool/internal/aws"
+	"time"
+)
 
 type ValidationStatus int
 
@@ -40,6 +43,8 @@ type ValidationResult struct {
 	Resources  []ResourceResult
 	Errors     []ValidationError
 	Warnings   []ValidationWarning
+	Drifts     []StackDrift
+	Flows      []FlowResult
 	Duration   time.Duration
 }
 
@@ -47,11 +52,35 @@ type ResourceResult struct {
 	Type     string
 	ID       string
 	Name     string
+	Required bool
 	Status   ResourceStatus
 	Expected map[string]interface{}
 	Actual   map[string]interface{}
 	Errors   []string
 	Warnings []string
+	Rules    []RuleResult
+	Duration time.Duration
+	// CloudFormationスタックで作成されたリソースの場合のスタック名と論理ID
+	StackName string
+	LogicalID string
+	// AWS APIのレスポンス（-vv の場合のみ）
+	APICalls []aws.APICall
+}
+
+// RuleResult はリソースに適用した検証ルールごとの結果
+type RuleResult struct {
+	Name         string
+	Type         string
+	Property     string
+	Operator     string
+	Expected     interface{}
+	Actual       interface{}
+	Severity     string
+	Passed       bool
+	Message      string
+	ErrorMessage string
+	Suggestion   string
+	DocumentRef  string
 }
 
 type ValidationError struct {
@@ -70,6 +99,50 @@ type ValidationWarning struct {
 	Message  string
 }
 
+// StackDrift はCloudFormationスタックのドリフト検出結果
+type StackDrift struct {
+	StackName       string
+	DetectionStatus string
+	DriftStatus     string
+	Resources       []ResourceDrift
+}
+
+type ResourceDrift struct {
+	LogicalID           string
+	PhysicalID          string
+	Type                string
+	DriftStatus         string
+	PropertyDifferences []PropertyDifference
+}
+
+type PropertyDifference struct {
+	PropertyPath   string
+	ExpectedValue  string
+	ActualValue    string
+	DifferenceType string
+}
+
+// FlowResult はstep YAMLのflowsに宣言した通信経路の到達性評価結果
+type FlowResult struct {
+	From      string
+	To        string
+	Protocol  string
+	Port      int
+	Reachable bool
+	// ENIがないため送信元・宛先のIPが分からず、到達性を判定できなかった（Reachableはfalse、BlockingHopは空）
+	Indeterminate bool
+	BlockingHop   string
+	Reason        string
+	Hops          []FlowHop
+}
+
+// FlowHop は通信経路上の各評価ポイント（SG、ルートテーブル、NACL）の結果
+type FlowHop struct {
+	Name    string
+	Allowed bool
+	Detail  string
+}
+
 type ValidationSummary struct {
 	TotalSteps   int
 	PassedSteps  int
@@ -109,3 +182,24 @@ func (s ResourceStatus) String() string {
 		return "UNKNOWN"
 	}
 }
+
+func (t ErrorType) String() string {
+	switch t {
+	case ErrorResourceNotFound:
+		return "RESOURCE_NOT_FOUND"
+	case ErrorPropertyMismatch:
+		return "PROPERTY_MISMATCH"
+	case ErrorConfigurationInvalid:
+		return "CONFIGURATION_INVALID"
+	case ErrorAWSAPIFailure:
+		return "AWS_API_FAILURE"
+	case ErrorAuthenticationFailure:
+		return "AUTHENTICATION_FAILURE"
+	case ErrorPermissionDenied:
+		return "PERMISSION_DENIED"
+	case ErrorNetworkFailure:
+		return "NETWORK_FAILURE"
+	default:
+		return "UNKNOWN"
+	}
+}